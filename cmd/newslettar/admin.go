@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"log"
+	"sync"
+	"time"
+)
+
+// Sonarr, Radarr, Readarr and Lidarr share the system/status, health and diskspace endpoints;
+// only the API version differs (v3 for Sonarr/Radarr, v1 for Readarr/Lidarr).
+
+// arrHealthRecord is one entry of an *arr /health response
+type arrHealthRecord struct {
+	Source  string `json:"source"`
+	Type    string `json:"type"` // "ok", "notice", "warning" or "error"
+	Message string `json:"message"`
+	WikiURL string `json:"wikiUrl"`
+}
+
+// arrDiskSpaceRecord is one entry of an *arr /diskspace response
+type arrDiskSpaceRecord struct {
+	Path       string `json:"path"`
+	Label      string `json:"label"`
+	FreeSpace  int64  `json:"freeSpace"`
+	TotalSpace int64  `json:"totalSpace"`
+}
+
+// adminTarget is one *arr instance checked by the admin digest
+type adminTarget struct {
+	Instance   ArrInstance
+	Service    string
+	APIVersion string
+}
+
+// adminTargets returns every configured Sonarr, Radarr, Readarr and Lidarr instance
+func adminTargets(cfg *Config) []adminTarget {
+	targets := []adminTarget{}
+	for _, inst := range configuredInstances(cfg.SonarrInstances) {
+		targets = append(targets, adminTarget{inst, "Sonarr", "v3"})
+	}
+	for _, inst := range configuredInstances(cfg.RadarrInstances) {
+		targets = append(targets, adminTarget{inst, "Radarr", "v3"})
+	}
+	for _, inst := range configuredInstances(cfg.ReadarrInstances) {
+		targets = append(targets, adminTarget{inst, "Readarr", "v1"})
+	}
+	if cfg.LidarrURL != "" && cfg.LidarrAPIKey != "" {
+		lidarr := ArrInstance{Name: "Lidarr", URL: cfg.LidarrURL, APIKey: cfg.LidarrAPIKey}
+		targets = append(targets, adminTarget{lidarr, "Lidarr", "v1"})
+	}
+	return targets
+}
+
+// fetchArrHealth queries the version, health checks and disk space of one *arr instance.
+// Health data is never cached so the digest always reflects the current state.
+func fetchArrHealth(ctx context.Context, target adminTarget, diskWarningPercent int) AdminInstanceReport {
+	inst := target.Instance
+	report := AdminInstanceReport{Name: inst.Name, Service: target.Service}
+	baseURL := fmt.Sprintf("%s/api/%s", inst.URL, target.APIVersion)
+
+	var status struct {
+		Version string `json:"version"`
+	}
+	if _, err := arrGet(ctx, inst, baseURL+"/system/status", &status); err != nil {
+		report.Error = err.Error()
+		return report
+	}
+	report.Reachable = true
+	report.Version = status.Version
+
+	var health []arrHealthRecord
+	if _, err := arrGet(ctx, inst, baseURL+"/health", &health); err != nil {
+		report.Issues = append(report.Issues, HealthIssue{
+			Type:    "warning",
+			Source:  "Newslettar",
+			Message: fmt.Sprintf("Could not fetch health checks: %v", err),
+		})
+	}
+	for _, h := range health {
+		if h.Type == "ok" {
+			continue
+		}
+		report.Issues = append(report.Issues, HealthIssue{
+			Type:    h.Type,
+			Source:  h.Source,
+			Message: h.Message,
+			WikiURL: h.WikiURL,
+		})
+	}
+
+	var disks []arrDiskSpaceRecord
+	if _, err := arrGet(ctx, inst, baseURL+"/diskspace", &disks); err != nil {
+		report.Issues = append(report.Issues, HealthIssue{
+			Type:    "warning",
+			Source:  "Newslettar",
+			Message: fmt.Sprintf("Could not fetch disk space: %v", err),
+		})
+	}
+	for _, d := range disks {
+		if d.TotalSpace <= 0 {
+			continue
+		}
+		freePercent := int(d.FreeSpace * 100 / d.TotalSpace)
+		path := d.Path
+		if d.Label != "" && d.Label != d.Path {
+			path = fmt.Sprintf("%s (%s)", d.Path, d.Label)
+		}
+		report.Disks = append(report.Disks, DiskReport{
+			Path:        path,
+			Free:        formatBytes(d.FreeSpace),
+			Total:       formatBytes(d.TotalSpace),
+			FreePercent: freePercent,
+			Low:         freePercent < diskWarningPercent,
+		})
+	}
+
+	return report
+}
+
+// problemCount returns the number of things an admin should look at on this instance
+func (r AdminInstanceReport) problemCount() int {
+	if !r.Reachable {
+		return 1
+	}
+	count := 0
+	for _, issue := range r.Issues {
+		if issue.Type == "warning" || issue.Type == "error" {
+			count++
+		}
+	}
+	for _, disk := range r.Disks {
+		if disk.Low {
+			count++
+		}
+	}
+	return count
+}
+
+// buildAdminDigest checks every configured *arr instance in parallel
+func buildAdminDigest(ctx context.Context, cfg *Config) AdminDigestData {
+	targets := adminTargets(cfg)
+	reports := make([]AdminInstanceReport, len(targets))
+
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target adminTarget) {
+			defer wg.Done()
+			reports[i] = fetchArrHealth(ctx, target, cfg.DiskWarningPercent)
+			if !reports[i].Reachable {
+				log.Printf("⚠️  %s is unreachable: %s", target.Instance.Name, reports[i].Error)
+			}
+		}(i, target)
+	}
+	wg.Wait()
+
+	data := AdminDigestData{
+		GeneratedAt:        time.Now().In(getTimezone(cfg.Timezone)).Format("Monday, January 2, 2006 15:04 MST"),
+		Instances:          reports,
+		DiskWarningPercent: cfg.DiskWarningPercent,
+		DarkMode:           cfg.DarkMode,
+	}
+	for i := range reports {
+		reports[i].Problems = reports[i].problemCount()
+		data.ProblemCount += reports[i].Problems
+	}
+	return data
+}
+
+// runAdminDigest builds the admin health digest and sends it to ADMIN_EMAILS
+func runAdminDigest() {
+	cfg := getConfig()
+	if len(cfg.AdminEmails) == 0 {
+		log.Println("ℹ️  No admin recipients configured. Skipping admin digest.")
+		return
+	}
+
+	log.Println("🩺 Building admin health digest...")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.APITimeout)*time.Second)
+	defer cancel()
+
+	data := buildAdminDigest(ctx, cfg)
+	html, err := generateAdminDigestHTML(data)
+	if err != nil {
+		log.Printf("❌ Failed to generate admin digest HTML: %v", err)
+		return
+	}
+
+	subject := "✅ Newslettar admin digest - all systems healthy"
+	if data.ProblemCount > 0 {
+		subject = fmt.Sprintf("⚠️ Newslettar admin digest - %d issue(s) need attention", data.ProblemCount)
+	}
+
+	if err := sendEmailTo(cfg, cfg.AdminEmails, subject, emailBody{HTML: html}); err != nil {
+		log.Printf("❌ Failed to send admin digest: %v", err)
+		return
+	}
+	log.Printf("✅ Admin digest sent (%d issue(s))", data.ProblemCount)
+}
+
+func generateAdminDigestHTML(data AdminDigestData) (string, error) {
+	var buf bytes.Buffer
+	if err := adminTemplate.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func initAdminTemplate() (*template.Template, error) {
+	return template.New("admin.html").ParseFS(templateFS, "templates/admin.html")
+}
+
+// formatBytes formats a byte count as "512 MB", "120.5 GB" or "3.2 TB"
+func formatBytes(size int64) string {
+	const gb = 1 << 30
+	switch {
+	case size >= 1<<40:
+		return fmt.Sprintf("%.1f TB", float64(size)/(1<<40))
+	case size >= gb:
+		return fmt.Sprintf("%.1f GB", float64(size)/gb)
+	default:
+		return fmt.Sprintf("%d MB", size>>20)
+	}
+}
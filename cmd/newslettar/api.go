@@ -46,39 +46,39 @@ func retryWithBackoff[T any](operation func() (T, error), operationName string,
 }
 
 // Retry wrappers for API calls
-func fetchSonarrHistoryWithRetry(ctx context.Context, cfg *Config, since time.Time, maxRetries int) ([]Episode, error) {
+func fetchSonarrHistoryWithRetry(ctx context.Context, cfg *Config, inst ArrInstance, since time.Time, maxRetries int) ([]Episode, error) {
 	return retryWithBackoff(func() ([]Episode, error) {
-		return fetchSonarrHistory(ctx, cfg, since)
-	}, "Sonarr history", maxRetries)
+		return fetchSonarrHistory(ctx, cfg, inst, since)
+	}, inst.Name+" history", maxRetries)
 }
 
-func fetchSonarrCalendarWithRetry(ctx context.Context, cfg *Config, start, end time.Time, maxRetries int) ([]Episode, error) {
+func fetchSonarrCalendarWithRetry(ctx context.Context, cfg *Config, inst ArrInstance, start, end time.Time, maxRetries int) ([]Episode, error) {
 	return retryWithBackoff(func() ([]Episode, error) {
-		return fetchSonarrCalendar(ctx, cfg, start, end)
-	}, "Sonarr calendar", maxRetries)
+		return fetchSonarrCalendar(ctx, cfg, inst, start, end)
+	}, inst.Name+" calendar", maxRetries)
 }
 
-func fetchRadarrHistoryWithRetry(ctx context.Context, cfg *Config, since time.Time, maxRetries int) ([]Movie, error) {
+func fetchRadarrHistoryWithRetry(ctx context.Context, cfg *Config, inst ArrInstance, since time.Time, maxRetries int) ([]Movie, error) {
 	return retryWithBackoff(func() ([]Movie, error) {
-		return fetchRadarrHistory(ctx, cfg, since)
-	}, "Radarr history", maxRetries)
+		return fetchRadarrHistory(ctx, cfg, inst, since)
+	}, inst.Name+" history", maxRetries)
 }
 
-func fetchRadarrCalendarWithRetry(ctx context.Context, cfg *Config, start, end time.Time, maxRetries int) ([]Movie, error) {
+func fetchRadarrCalendarWithRetry(ctx context.Context, cfg *Config, inst ArrInstance, start, end time.Time, maxRetries int) ([]Movie, error) {
 	return retryWithBackoff(func() ([]Movie, error) {
-		return fetchRadarrCalendar(ctx, cfg, start, end)
-	}, "Radarr calendar", maxRetries)
+		return fetchRadarrCalendar(ctx, cfg, inst, start, end)
+	}, inst.Name+" calendar", maxRetries)
 }
 
-func fetchSonarrHistory(ctx context.Context, cfg *Config, since time.Time) ([]Episode, error) {
-	if cfg.SonarrURL == "" || cfg.SonarrAPIKey == "" {
-		return nil, fmt.Errorf("sonarr not configured")
+func fetchSonarrHistory(ctx context.Context, cfg *Config, inst ArrInstance, since time.Time) ([]Episode, error) {
+	if !inst.configured() {
+		return nil, fmt.Errorf("%s not configured", inst.Name)
 	}
 
 	// Check cache first
-	cacheKey := getCacheKey("sonarr_history", cfg.SonarrURL, since.Unix())
+	cacheKey := getCacheKey("sonarr_history", inst.URL, since.Unix())
 	if cached, found := apiCache.Get(cacheKey); found {
-		log.Printf("📦 Using cached Sonarr history")
+		log.Printf("📦 Using cached %s history", inst.Name)
 		return cached.([]Episode), nil
 	}
 
@@ -86,12 +86,12 @@ func fetchSonarrHistory(ctx context.Context, cfg *Config, since time.Time) ([]Ep
 	page := 1
 
 	for {
-		url := fmt.Sprintf("%s/api/v3/history?page=%d&pageSize=%d&sortKey=date&sortDirection=descending&includeEpisode=true&includeSeries=true", cfg.SonarrURL, page, cfg.APIPageSize)
+		url := fmt.Sprintf("%s/api/v3/history?page=%d&pageSize=%d&sortKey=date&sortDirection=descending&includeEpisode=true&includeSeries=true", inst.URL, page, cfg.APIPageSize)
 		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return nil, err
 		}
-		req.Header.Set("X-Api-Key", cfg.SonarrAPIKey)
+		req.Header.Set("X-Api-Key", inst.APIKey)
 
 		resp, err := httpClient.Do(req)
 		if err != nil {
@@ -114,6 +114,8 @@ func fetchSonarrHistory(ctx context.Context, cfg *Config, since time.Time) ([]Ep
 			Records      []struct {
 				Date      time.Time `json:"date"`
 				EventType string    `json:"eventType"`
+				SeriesID  int       `json:"seriesId"`
+				EpisodeID int       `json:"episodeId"`
 				Data map[string]string `json:"data"`
 				Series struct {
 					Title     string `json:"title"`
@@ -170,19 +172,22 @@ func fetchSonarrHistory(ctx context.Context, cfg *Config, since time.Time) ([]Ep
 			}
 
 			episodes = append(episodes, Episode{
-				SeriesTitle:    record.Series.Title,
-				SeasonNum:      record.Episode.SeasonNumber,
-				EpisodeNum:     record.Episode.EpisodeNumber,
-				Title:          record.Episode.Title,
-				AirDate:        record.Episode.AirDate,
-				Downloaded:     true,
-				PosterURL:      posterURL,
-				IMDBID:         record.Series.ImdbID,
-				TvdbID:         record.Series.TvdbID,
-				Overview:       record.Episode.Overview,
-				SeriesOverview: record.Series.Overview,
-				Monitored:      record.Series.Monitored,
-				Rating:         record.Series.Ratings.Value,
+				SeriesTitle:     record.Series.Title,
+				SeasonNum:       record.Episode.SeasonNumber,
+				EpisodeNum:      record.Episode.EpisodeNumber,
+				Title:           record.Episode.Title,
+				AirDate:         record.Episode.AirDate,
+				Downloaded:      true,
+				PosterURL:       posterURL,
+				IMDBID:          record.Series.ImdbID,
+				TvdbID:          record.Series.TvdbID,
+				Overview:        record.Episode.Overview,
+				SeriesOverview:  record.Series.Overview,
+				Monitored:       record.Series.Monitored,
+				Rating:          record.Series.Ratings.Value,
+				Instances:       []string{inst.Name},
+				SonarrSeriesID:  record.SeriesID,
+				SonarrEpisodeID: record.EpisodeID,
 			})
 		}
 
@@ -194,7 +199,7 @@ func fetchSonarrHistory(ctx context.Context, cfg *Config, since time.Time) ([]Ep
 		}
 
 		page++
-		log.Printf("📄 Fetching Sonarr history page %d...", page)
+		log.Printf("📄 Fetching %s history page %d...", inst.Name, page)
 	}
 
 	// Store in cache
@@ -203,22 +208,22 @@ func fetchSonarrHistory(ctx context.Context, cfg *Config, since time.Time) ([]Ep
 	return episodes, nil
 }
 
-func fetchSonarrCalendar(ctx context.Context, cfg *Config, start, end time.Time) ([]Episode, error) {
+func fetchSonarrCalendar(ctx context.Context, cfg *Config, inst ArrInstance, start, end time.Time) ([]Episode, error) {
 	// Check cache first
-	cacheKey := getCacheKey("sonarr_calendar", cfg.SonarrURL, start.Unix(), end.Unix())
+	cacheKey := getCacheKey("sonarr_calendar", inst.URL, start.Unix(), end.Unix())
 	if cached, found := apiCache.Get(cacheKey); found {
-		log.Printf("📦 Using cached Sonarr calendar")
+		log.Printf("📦 Using cached %s calendar", inst.Name)
 		return cached.([]Episode), nil
 	}
 
 	url := fmt.Sprintf("%s/api/v3/calendar?unmonitored=true&includeSeries=true&includeEpisodeImages=true&start=%s&end=%s",
-		cfg.SonarrURL, start.Format("2006-01-02"), end.Format("2006-01-02"))
+		inst.URL, start.Format("2006-01-02"), end.Format("2006-01-02"))
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("X-Api-Key", cfg.SonarrAPIKey)
+	req.Header.Set("X-Api-Key", inst.APIKey)
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
@@ -258,6 +263,8 @@ func fetchSonarrCalendar(ctx context.Context, cfg *Config, start, end time.Time)
 			EpisodeNum:     entry.EpisodeNumber,
 			Title:          entry.Title,
 			AirDate:        entry.AirDate,
+			AirDateUTC:     entry.AirDateUtc,
+			Runtime:        entry.Series.Runtime,
 			PosterURL:      posterURL,
 			IMDBID:         entry.Series.ImdbId,
 			TvdbID:         entry.Series.TvdbId,
@@ -265,6 +272,7 @@ func fetchSonarrCalendar(ctx context.Context, cfg *Config, start, end time.Time)
 			SeriesOverview: entry.Series.Overview,
 			Monitored:      entry.Series.Monitored,
 			Rating:         entry.Series.Ratings.Value, // Store series rating (used to populate SeriesGroup.SeriesRating)
+			Instances:      []string{inst.Name},
 		}
 
 		if ep.AirDate != "" {
@@ -284,15 +292,15 @@ func fetchSonarrCalendar(ctx context.Context, cfg *Config, start, end time.Time)
 	return episodes, nil
 }
 
-func fetchRadarrHistory(ctx context.Context, cfg *Config, since time.Time) ([]Movie, error) {
-	if cfg.RadarrURL == "" || cfg.RadarrAPIKey == "" {
-		return nil, fmt.Errorf("radarr not configured")
+func fetchRadarrHistory(ctx context.Context, cfg *Config, inst ArrInstance, since time.Time) ([]Movie, error) {
+	if !inst.configured() {
+		return nil, fmt.Errorf("%s not configured", inst.Name)
 	}
 
 	// Check cache first
-	cacheKey := getCacheKey("radarr_history", cfg.RadarrURL, since.Unix())
+	cacheKey := getCacheKey("radarr_history", inst.URL, since.Unix())
 	if cached, found := apiCache.Get(cacheKey); found {
-		log.Printf("📦 Using cached Radarr history")
+		log.Printf("📦 Using cached %s history", inst.Name)
 		return cached.([]Movie), nil
 	}
 
@@ -300,12 +308,12 @@ func fetchRadarrHistory(ctx context.Context, cfg *Config, since time.Time) ([]Mo
 	page := 1
 
 	for {
-		url := fmt.Sprintf("%s/api/v3/history?page=%d&pageSize=%d&sortKey=date&sortDirection=descending&includeMovie=true", cfg.RadarrURL, page, cfg.APIPageSize)
+		url := fmt.Sprintf("%s/api/v3/history?page=%d&pageSize=%d&sortKey=date&sortDirection=descending&includeMovie=true", inst.URL, page, cfg.APIPageSize)
 		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return nil, err
 		}
-		req.Header.Set("X-Api-Key", cfg.RadarrAPIKey)
+		req.Header.Set("X-Api-Key", inst.APIKey)
 
 		resp, err := httpClient.Do(req)
 		if err != nil {
@@ -328,6 +336,7 @@ func fetchRadarrHistory(ctx context.Context, cfg *Config, since time.Time) ([]Mo
 			Records      []struct {
 				Date      time.Time `json:"date"`
 				EventType string    `json:"eventType"`
+				MovieID   int       `json:"movieId"`
 				Data map[string]string `json:"data"`
 				Movie struct {
 					Title     string `json:"title"`
@@ -398,6 +407,8 @@ func fetchRadarrHistory(ctx context.Context, cfg *Config, since time.Time) ([]Mo
 				Overview:    record.Movie.Overview,
 				Monitored:   record.Movie.Monitored,
 				Rating:      rating,
+				Instances:   []string{inst.Name},
+				RadarrID:    record.MovieID,
 			})
 		}
 
@@ -409,7 +420,7 @@ func fetchRadarrHistory(ctx context.Context, cfg *Config, since time.Time) ([]Mo
 		}
 
 		page++
-		log.Printf("📄 Fetching Radarr history page %d...", page)
+		log.Printf("📄 Fetching %s history page %d...", inst.Name, page)
 	}
 
 	// Store in cache
@@ -418,22 +429,22 @@ func fetchRadarrHistory(ctx context.Context, cfg *Config, since time.Time) ([]Mo
 	return movies, nil
 }
 
-func fetchRadarrCalendar(ctx context.Context, cfg *Config, start, end time.Time) ([]Movie, error) {
+func fetchRadarrCalendar(ctx context.Context, cfg *Config, inst ArrInstance, start, end time.Time) ([]Movie, error) {
 	// Check cache first
-	cacheKey := getCacheKey("radarr_calendar", cfg.RadarrURL, start.Unix(), end.Unix())
+	cacheKey := getCacheKey("radarr_calendar", inst.URL, start.Unix(), end.Unix())
 	if cached, found := apiCache.Get(cacheKey); found {
-		log.Printf("📦 Using cached Radarr calendar")
+		log.Printf("📦 Using cached %s calendar", inst.Name)
 		return cached.([]Movie), nil
 	}
 
 	url := fmt.Sprintf("%s/api/v3/calendar?unmonitored=true&includeMovie=true&start=%s&end=%s",
-		cfg.RadarrURL, start.Format("2006-01-02"), end.Format("2006-01-02"))
+		inst.URL, start.Format("2006-01-02"), end.Format("2006-01-02"))
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("X-Api-Key", cfg.RadarrAPIKey)
+	req.Header.Set("X-Api-Key", inst.APIKey)
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
@@ -483,6 +494,7 @@ func fetchRadarrCalendar(ctx context.Context, cfg *Config, start, end time.Time)
 			Overview:    entry.Overview,
 			Monitored:   entry.Monitored,
 			Rating:      rating,
+			Instances:   []string{inst.Name},
 		}
 
 		if mv.ReleaseDate != "" {
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Every sent newsletter is kept as an HTML file in archiveDir and served under /archive/,
+// so push notifications (which only carry a short summary) can link to the full issue.
+// A small JSON sidecar (<id>.json) next to it holds what the RSS/Atom feeds need.
+
+const (
+	archiveDir      = "archive"
+	archiveIDFormat = "2006-01-02-150405"
+)
+
+// archiveIDPattern guards the /archive/ handler against path traversal
+var archiveIDPattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}-\d{6}$`)
+
+// archiveMeta describes an archived issue for the feeds
+type archiveMeta struct {
+	Subject   string        `json:"subject"`
+	Summary   string        `json:"summary"`
+	Published time.Time     `json:"published"`
+	Items     []archiveItem `json:"items"` // Downloaded series and movies, one feed entry each when FEED_ITEMS is on
+}
+
+// archiveItem is one downloaded series (with its episodes) or movie of an archived issue
+type archiveItem struct {
+	Key       string `json:"key"` // Stable within the issue, e.g. "tvdb-81189" or "tmdb-603"
+	Title     string `json:"title"`
+	Details   string `json:"details,omitempty"` // Episode list or release year
+	Overview  string `json:"overview,omitempty"`
+	PosterURL string `json:"poster_url,omitempty"` // Absolute URL only, used as the feed enclosure
+}
+
+// archiveID is the ID an issue is archived under, known before it is saved
+// so the notifications can already link to it
+func archiveID(issue *NewsletterIssue) string {
+	return issue.Period.End.Format(archiveIDFormat)
+}
+
+// saveArchive stores the issue HTML and its feed metadata under archiveID
+func saveArchive(issue *NewsletterIssue) error {
+	if err := os.MkdirAll(archiveDir, 0755); err != nil {
+		return err
+	}
+	id := archiveID(issue)
+	if err := os.WriteFile(filepath.Join(archiveDir, id+".html"), []byte(issue.HTML), 0644); err != nil {
+		return err
+	}
+	meta, err := json.Marshal(buildArchiveMeta(issue))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(archiveDir, id+".json"), meta, 0644)
+}
+
+// buildArchiveMeta extracts the feed metadata from an issue
+func buildArchiveMeta(issue *NewsletterIssue) archiveMeta {
+	data := issue.Data
+	meta := archiveMeta{
+		Subject:   issue.Subject,
+		Summary:   newsletterSummary(data, issue.Period),
+		Published: issue.Period.End,
+		Items:     []archiveItem{},
+	}
+	if !data.ShowDownloaded {
+		return meta
+	}
+	for i, group := range data.DownloadedSeriesGroups {
+		key := fmt.Sprintf("series-%d", i)
+		if group.TvdbID != 0 {
+			key = fmt.Sprintf("tvdb-%d", group.TvdbID)
+		}
+		episodes := make([]string, 0, len(group.Episodes))
+		for _, ep := range group.Episodes {
+			episodes = append(episodes, fmt.Sprintf("S%02dE%02d %s", ep.SeasonNum, ep.EpisodeNum, ep.Title))
+		}
+		meta.Items = append(meta.Items, archiveItem{
+			Key:       key,
+			Title:     group.SeriesTitle,
+			Details:   strings.Join(episodes, "\n"),
+			Overview:  group.Overview,
+			PosterURL: publicPosterURL(data, group.PosterURL),
+		})
+	}
+	for i, movie := range data.DownloadedMovies {
+		key := fmt.Sprintf("movie-%d", i)
+		if movie.TmdbID != 0 {
+			key = fmt.Sprintf("tmdb-%d", movie.TmdbID)
+		}
+		title := movie.Title
+		if movie.Year > 0 {
+			title = fmt.Sprintf("%s (%d)", movie.Title, movie.Year)
+		}
+		meta.Items = append(meta.Items, archiveItem{
+			Key:       key,
+			Title:     title,
+			Overview:  movie.Overview,
+			PosterURL: publicPosterURL(data, movie.PosterURL),
+		})
+	}
+	return meta
+}
+
+// loadArchiveMeta reads the feed metadata saved next to an archived issue
+func loadArchiveMeta(id string) (archiveMeta, error) {
+	var meta archiveMeta
+	raw, err := os.ReadFile(filepath.Join(archiveDir, id+".json"))
+	if err != nil {
+		return meta, err
+	}
+	err = json.Unmarshal(raw, &meta)
+	return meta, err
+}
+
+// archiveURL returns the public link to an archived issue, or "" when PUBLIC_URL isn't set
+func archiveURL(cfg *Config, id string) string {
+	if cfg.PublicURL == "" || id == "" {
+		return ""
+	}
+	return cfg.PublicURL + "/archive/" + id
+}
+
+// listArchive returns the archived issue IDs, newest first
+func listArchive() []string {
+	files, err := filepath.Glob(filepath.Join(archiveDir, "*.html"))
+	if err != nil {
+		return nil
+	}
+	ids := []string{}
+	for _, file := range files {
+		if id := strings.TrimSuffix(filepath.Base(file), ".html"); archiveIDPattern.MatchString(id) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(ids)))
+	return ids
+}
+
+// archiveHandler serves the archive index at /archive/ and single issues at /archive/<id>
+func archiveHandler(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/archive/"), ".html")
+
+	if id == "" {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprint(w, `<!DOCTYPE html><html><head><meta charset="utf-8"><meta name="viewport" content="width=device-width, initial-scale=1"><title>Newsletter archive</title>`+
+			`<link rel="alternate" type="application/atom+xml" title="Newsletter (Atom)" href="/feed.xml">`+
+			`<link rel="alternate" type="application/rss+xml" title="Newsletter (RSS)" href="/rss.xml"></head>`+
+			`<body style="font-family: sans-serif; max-width: 600px; margin: 40px auto; padding: 0 20px;"><h1>Newsletter archive</h1><ul>`)
+		for _, id := range listArchive() {
+			label := id
+			if t, err := time.Parse(archiveIDFormat, id); err == nil {
+				label = t.Format("Monday, January 2, 2006 15:04")
+			}
+			fmt.Fprintf(w, `<li><a href="/archive/%s">%s</a></li>`, id, template.HTMLEscapeString(label))
+		}
+		fmt.Fprint(w, `</ul></body></html>`)
+		return
+	}
+
+	if !archiveIDPattern.MatchString(id) {
+		http.NotFound(w, r)
+		return
+	}
+	html, err := os.ReadFile(filepath.Join(archiveDir, id+".html"))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("⚠️  Failed to read archived newsletter %s: %v", id, err)
+		}
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(html)
+}
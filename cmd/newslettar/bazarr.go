@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Bazarr stores subtitles per Sonarr episode and Radarr movie, keyed by their Sonarr/Radarr IDs.
+// Every response is wrapped in {"data": [...]}; the API key goes in the X-API-KEY header.
+// Bazarr only syncs with one Sonarr and one Radarr, so matches are checked against the
+// TVDB/IMDB IDs to ignore items of other instances that happen to share an ID.
+
+// bazarrIDChunk is the number of IDs requested at once (keeps URLs short)
+const bazarrIDChunk = 100
+
+// bazarrSubtitle is one subtitle (or missing subtitle) language of a Bazarr item
+type bazarrSubtitle struct {
+	Name   string `json:"name"`
+	Code2  string `json:"code2"`
+	Forced bool   `json:"forced"`
+	HI     bool   `json:"hi"`
+}
+
+// bazarrSubtitles is the subtitle state shared by Bazarr episodes and movies
+type bazarrSubtitles struct {
+	Subtitles        []bazarrSubtitle `json:"subtitles"`
+	MissingSubtitles []bazarrSubtitle `json:"missing_subtitles"`
+}
+
+// bazarrConfigured reports whether a Bazarr server is configured
+func bazarrConfigured(cfg *Config) bool {
+	return cfg.BazarrURL != "" && cfg.BazarrAPIKey != ""
+}
+
+// bazarrGet requests a Bazarr API path and decodes the data payload into v
+func bazarrGet(ctx context.Context, baseURL, apiKey, path string, params url.Values, v interface{}) error {
+	reqURL := strings.TrimSuffix(baseURL, "/") + "/api" + path
+	if len(params) > 0 {
+		reqURL += "?" + params.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-API-KEY", apiKey)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API request failed with status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+	return json.Unmarshal(result.Data, v)
+}
+
+// bazarrGetByIDs requests path for the given IDs in chunks, passing them as repeated param[] values
+func bazarrGetByIDs[T any](ctx context.Context, cfg *Config, path, param string, ids []int) ([]T, error) {
+	all := []T{}
+	for start := 0; start < len(ids); start += bazarrIDChunk {
+		end := start + bazarrIDChunk
+		if end > len(ids) {
+			end = len(ids)
+		}
+		params := url.Values{}
+		for _, id := range ids[start:end] {
+			params.Add(param+"[]", strconv.Itoa(id))
+		}
+		var items []T
+		if err := bazarrGet(ctx, cfg.BazarrURL, cfg.BazarrAPIKey, path, params, &items); err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+	}
+	return all, nil
+}
+
+// subtitleLanguages returns the sorted, de-duplicated language codes of a subtitle list ("EN", "FR (forced)")
+func subtitleLanguages(subtitles []bazarrSubtitle) []string {
+	seen := make(map[string]bool)
+	languages := []string{}
+	for _, sub := range subtitles {
+		lang := strings.ToUpper(sub.Code2)
+		if lang == "" {
+			lang = sub.Name
+		}
+		if sub.Forced {
+			lang += " (forced)"
+		} else if sub.HI {
+			lang += " (HI)"
+		}
+		if lang != "" && !seen[lang] {
+			seen[lang] = true
+			languages = append(languages, lang)
+		}
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// addEpisodeSubtitles annotates downloaded episodes with their Bazarr subtitle languages
+func addEpisodeSubtitles(ctx context.Context, cfg *Config, episodes []Episode) error {
+	seriesIDs, episodeIDs := []int{}, []int{}
+	seenSeries := make(map[int]bool)
+	for _, ep := range episodes {
+		if ep.SonarrEpisodeID == 0 {
+			continue
+		}
+		episodeIDs = append(episodeIDs, ep.SonarrEpisodeID)
+		if !seenSeries[ep.SonarrSeriesID] {
+			seenSeries[ep.SonarrSeriesID] = true
+			seriesIDs = append(seriesIDs, ep.SonarrSeriesID)
+		}
+	}
+	if len(episodeIDs) == 0 {
+		return nil
+	}
+
+	// TVDB IDs of the series Bazarr knows, to verify the Sonarr IDs belong to its instance
+	series, err := bazarrGetByIDs[struct {
+		SonarrSeriesID int `json:"sonarrSeriesId"`
+		TvdbID         int `json:"tvdbId"`
+	}](ctx, cfg, "/series", "seriesid", seriesIDs)
+	if err != nil {
+		return fmt.Errorf("failed to fetch Bazarr series: %w", err)
+	}
+	seriesTvdb := make(map[int]int)
+	for _, s := range series {
+		seriesTvdb[s.SonarrSeriesID] = s.TvdbID
+	}
+
+	bazarrEpisodes, err := bazarrGetByIDs[struct {
+		SonarrEpisodeID int `json:"sonarrEpisodeId"`
+		bazarrSubtitles
+	}](ctx, cfg, "/episodes", "episodeid", episodeIDs)
+	if err != nil {
+		return fmt.Errorf("failed to fetch Bazarr episodes: %w", err)
+	}
+	byID := make(map[int]bazarrSubtitles)
+	for _, ep := range bazarrEpisodes {
+		byID[ep.SonarrEpisodeID] = ep.bazarrSubtitles
+	}
+
+	for i, ep := range episodes {
+		tvdbID, known := seriesTvdb[ep.SonarrSeriesID]
+		if !known || (tvdbID != 0 && ep.TvdbID != 0 && tvdbID != ep.TvdbID) {
+			continue
+		}
+		if subs, found := byID[ep.SonarrEpisodeID]; found {
+			episodes[i].Subtitles = subtitleLanguages(subs.Subtitles)
+			episodes[i].MissingSubtitles = subtitleLanguages(subs.MissingSubtitles)
+		}
+	}
+	return nil
+}
+
+// addMovieSubtitles annotates downloaded movies with their Bazarr subtitle languages
+func addMovieSubtitles(ctx context.Context, cfg *Config, movies []Movie) error {
+	movieIDs := []int{}
+	for _, m := range movies {
+		if m.RadarrID != 0 {
+			movieIDs = append(movieIDs, m.RadarrID)
+		}
+	}
+	if len(movieIDs) == 0 {
+		return nil
+	}
+
+	bazarrMovies, err := bazarrGetByIDs[struct {
+		RadarrID int    `json:"radarrId"`
+		ImdbID   string `json:"imdbId"`
+		bazarrSubtitles
+	}](ctx, cfg, "/movies", "radarrid", movieIDs)
+	if err != nil {
+		return fmt.Errorf("failed to fetch Bazarr movies: %w", err)
+	}
+
+	for _, bm := range bazarrMovies {
+		for i, m := range movies {
+			if m.RadarrID != bm.RadarrID || (bm.ImdbID != "" && m.IMDBID != "" && bm.ImdbID != m.IMDBID) {
+				continue
+			}
+			movies[i].Subtitles = subtitleLanguages(bm.Subtitles)
+			movies[i].MissingSubtitles = subtitleLanguages(bm.MissingSubtitles)
+		}
+	}
+	return nil
+}
+
+// addSubtitles annotates the downloaded episodes and movies with Bazarr subtitle languages.
+// Bazarr is optional: failures are logged and leave the items unannotated.
+func addSubtitles(ctx context.Context, cfg *Config, episodes []Episode, movies []Movie) {
+	if !bazarrConfigured(cfg) || !cfg.ShowSubtitles {
+		return
+	}
+	log.Println("💬 Fetching Bazarr subtitles...")
+	if err := addEpisodeSubtitles(ctx, cfg, episodes); err != nil {
+		log.Printf("⚠️  Bazarr error: %v", err)
+	}
+	if err := addMovieSubtitles(ctx, cfg, movies); err != nil {
+		log.Printf("⚠️  Bazarr error: %v", err)
+	}
+}
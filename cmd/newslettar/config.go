@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"os"
 	"strconv"
@@ -34,14 +35,7 @@ func reloadConfig() {
 func loadConfig() *Config {
 	envMap := readEnvFile()
 
-	toEmailsStr := getEnvFromFile(envMap, "TO_EMAILS", "")
-	toEmails := []string{}
-	if toEmailsStr != "" {
-		toEmails = strings.Split(toEmailsStr, ",")
-		for i := range toEmails {
-			toEmails[i] = strings.TrimSpace(toEmails[i])
-		}
-	}
+	toEmails := parseList(getEnvFromFile(envMap, "TO_EMAILS", ""))
 
 	// Support backward compatibility with old MAILGUN_* env vars
 	smtpHost := getEnvFromFile(envMap, "SMTP_HOST", "")
@@ -66,14 +60,51 @@ func loadConfig() *Config {
 		SonarrAPIKey:                getEnvFromFileOnly(envMap, "SONARR_API_KEY", ""),
 		RadarrURL:                   getEnvFromFileOnly(envMap, "RADARR_URL", ""),
 		RadarrAPIKey:                getEnvFromFileOnly(envMap, "RADARR_API_KEY", ""),
+		SonarrInstances:             loadArrInstances(envMap, "SONARR", DefaultSonarrName),
+		RadarrInstances:             loadArrInstances(envMap, "RADARR", DefaultRadarrName),
+		ReadarrInstances:            loadArrInstances(envMap, "READARR", DefaultReadarrName),
 		TraktClientID:               getEnvFromFileOnly(envMap, "TRAKT_CLIENT_ID", ""),
+		TraktClientSecret:           getEnvFromFileOnly(envMap, "TRAKT_CLIENT_SECRET", ""),
+		TMDBAPIKey:                  getEnvFromFileOnly(envMap, "TMDB_API_KEY", ""),
+		JellyfinURL:                 strings.TrimSuffix(getEnvFromFileOnly(envMap, "JELLYFIN_URL", ""), "/"),
+		JellyfinAPIKey:              getEnvFromFileOnly(envMap, "JELLYFIN_API_KEY", ""),
+		JellyfinServerType:          getEnvFromFile(envMap, "JELLYFIN_SERVER_TYPE", DefaultJellyfinServerType),
+		PlexURL:                     strings.TrimSuffix(getEnvFromFileOnly(envMap, "PLEX_URL", ""), "/"),
+		PlexToken:                   getEnvFromFileOnly(envMap, "PLEX_TOKEN", ""),
+		LidarrURL:                   strings.TrimSuffix(getEnvFromFileOnly(envMap, "LIDARR_URL", ""), "/"),
+		LidarrAPIKey:                getEnvFromFileOnly(envMap, "LIDARR_API_KEY", ""),
+		OverseerrURL:                strings.TrimSuffix(getEnvFromFileOnly(envMap, "OVERSEERR_URL", ""), "/"),
+		OverseerrAPIKey:             getEnvFromFileOnly(envMap, "OVERSEERR_API_KEY", ""),
+		TautulliURL:                 strings.TrimSuffix(getEnvFromFileOnly(envMap, "TAUTULLI_URL", ""), "/"),
+		TautulliAPIKey:              getEnvFromFileOnly(envMap, "TAUTULLI_API_KEY", ""),
+		BazarrURL:                   strings.TrimSuffix(getEnvFromFileOnly(envMap, "BAZARR_URL", ""), "/"),
+		BazarrAPIKey:                getEnvFromFileOnly(envMap, "BAZARR_API_KEY", ""),
 		SMTPHost:                    smtpHost,
 		SMTPPort:                    smtpPort,
 		SMTPUser:                    smtpUser,
 		SMTPPass:                    smtpPass,
+		SMTPSecurity:                getEnvFromFile(envMap, "SMTP_SECURITY", DefaultSMTPSecurity),
+		SMTPCAFile:                  getEnvFromFile(envMap, "SMTP_CA_FILE", ""),
+		SMTPTLSSkipVerify:           getEnvFromFile(envMap, "SMTP_TLS_SKIP_VERIFY", DefaultSMTPTLSSkipVerify) == "true",
 		FromEmail:                   getEnvFromFile(envMap, "FROM_EMAIL", ""),
 		FromName:                    getEnvFromFile(envMap, "FROM_NAME", DefaultFromName),
 		ToEmails:                    toEmails,
+		SlackWebhookURL:             getEnvFromFileOnly(envMap, "SLACK_WEBHOOK_URL", ""),
+		DiscordWebhookURL:           getEnvFromFileOnly(envMap, "DISCORD_WEBHOOK_URL", ""),
+		TelegramBotToken:            getEnvFromFileOnly(envMap, "TELEGRAM_BOT_TOKEN", ""),
+		TelegramChatIDs:             parseList(getEnvFromFileOnly(envMap, "TELEGRAM_CHAT_IDS", "")),
+		TelegramAPIURL:              strings.TrimSuffix(getEnvFromFile(envMap, "TELEGRAM_API_URL", DefaultTelegramAPIURL), "/"),
+		MatrixHomeserverURL:         strings.TrimSuffix(getEnvFromFileOnly(envMap, "MATRIX_HOMESERVER_URL", ""), "/"),
+		MatrixAccessToken:           getEnvFromFileOnly(envMap, "MATRIX_ACCESS_TOKEN", ""),
+		MatrixRoomID:                getEnvFromFileOnly(envMap, "MATRIX_ROOM_ID", ""),
+		NtfyURL:                     strings.TrimSuffix(getEnvFromFile(envMap, "NTFY_URL", DefaultNtfyURL), "/"),
+		NtfyTopic:                   getEnvFromFileOnly(envMap, "NTFY_TOPIC", ""),
+		NtfyToken:                   getEnvFromFileOnly(envMap, "NTFY_TOKEN", ""),
+		GotifyURL:                   strings.TrimSuffix(getEnvFromFileOnly(envMap, "GOTIFY_URL", ""), "/"),
+		GotifyToken:                 getEnvFromFileOnly(envMap, "GOTIFY_TOKEN", ""),
+		WebhookURL:                  getEnvFromFileOnly(envMap, "WEBHOOK_URL", ""),
+		WebhookSecret:               getEnvFromFileOnly(envMap, "WEBHOOK_SECRET", ""),
+		PublicURL:                   strings.TrimSuffix(getEnvFromFile(envMap, "PUBLIC_URL", ""), "/"),
 		Timezone:                    getEnvFromFile(envMap, "TIMEZONE", DefaultTimezone),
 		ScheduleDay:                 getEnvFromFile(envMap, "SCHEDULE_DAY", DefaultScheduleDay),
 		ScheduleTime:                getEnvFromFile(envMap, "SCHEDULE_TIME", DefaultScheduleTime),
@@ -94,6 +125,36 @@ func loadConfig() *Config {
 		TraktWatchedSeriesLimit:     getEnvIntFromFile(envMap, "TRAKT_WATCHED_SERIES_LIMIT", 5),
 		TraktAnticipatedMoviesLimit: getEnvIntFromFile(envMap, "TRAKT_ANTICIPATED_MOVIES_LIMIT", 5),
 		TraktWatchedMoviesLimit:     getEnvIntFromFile(envMap, "TRAKT_WATCHED_MOVIES_LIMIT", 5),
+		ShowServerMostWatched:       getEnvFromFile(envMap, "SHOW_SERVER_MOST_WATCHED", DefaultShowServerMostWatched) != "false",
+		ShowServerRecentlyAdded:     getEnvFromFile(envMap, "SHOW_SERVER_RECENTLY_ADDED", DefaultShowServerRecentlyAdded) != "false",
+		ServerMostWatchedLimit:      getEnvIntFromFile(envMap, "SERVER_MOST_WATCHED_LIMIT", DefaultServerMostWatchedLimit),
+		ServerRecentlyAddedLimit:    getEnvIntFromFile(envMap, "SERVER_RECENTLY_ADDED_LIMIT", DefaultServerRecentlyAddedLimit),
+		ShowMusic:                   getEnvFromFile(envMap, "SHOW_MUSIC", DefaultShowMusic) != "false",
+		ShowBooks:                   getEnvFromFile(envMap, "SHOW_BOOKS", DefaultShowBooks) != "false",
+		ShowPendingRequests:         getEnvFromFile(envMap, "SHOW_PENDING_REQUESTS", DefaultShowPendingRequests) != "false",
+		PendingRequestsLimit:        getEnvIntFromFile(envMap, "PENDING_REQUESTS_LIMIT", DefaultPendingRequestsLimit),
+		ShowServerStats:             getEnvFromFile(envMap, "SHOW_SERVER_STATS", DefaultShowServerStats) != "false",
+		ShowSubtitles:               getEnvFromFile(envMap, "SHOW_SUBTITLES", DefaultShowSubtitles) != "false",
+		ServerStatsLimit:            getEnvIntFromFile(envMap, "SERVER_STATS_LIMIT", DefaultServerStatsLimit),
+		ShowQueue:                   getEnvFromFile(envMap, "SHOW_QUEUE", DefaultShowQueue) != "false",
+		QueueLimit:                  getEnvIntFromFile(envMap, "QUEUE_LIMIT", DefaultQueueLimit),
+		ShowMissing:                 getEnvFromFile(envMap, "SHOW_MISSING", DefaultShowMissing) != "false",
+		MissingLimit:                getEnvIntFromFile(envMap, "MISSING_LIMIT", DefaultMissingLimit),
+		ShowTraktWatchlist:          getEnvFromFile(envMap, "SHOW_TRAKT_WATCHLIST", DefaultShowTraktWatchlist) != "false",
+		ShowTraktRecommendations:    getEnvFromFile(envMap, "SHOW_TRAKT_RECOMMENDATIONS", DefaultShowTraktRecommendations) != "false",
+		ShowTraktCalendar:           getEnvFromFile(envMap, "SHOW_TRAKT_CALENDAR", DefaultShowTraktCalendar) != "false",
+		TraktRecommendationsLimit:   getEnvIntFromFile(envMap, "TRAKT_RECOMMENDATIONS_LIMIT", DefaultTraktRecommendationsLimit),
+		TraktCalendarLimit:          getEnvIntFromFile(envMap, "TRAKT_CALENDAR_LIMIT", DefaultTraktCalendarLimit),
+		TraktLists:                  loadTraktLists(envMap),
+		FeedItems:                   getEnvFromFile(envMap, "FEED_ITEMS", DefaultFeedItems) != "false",
+		EmailAttachICS:              getEnvFromFile(envMap, "EMAIL_ATTACH_ICS", DefaultEmailAttachICS) != "false",
+		EmailInlinePosters:          getEnvFromFile(envMap, "EMAIL_INLINE_POSTERS", DefaultEmailInlinePosters) != "false",
+		// Admin digest
+		AdminEmails:        parseList(getEnvFromFile(envMap, "ADMIN_EMAILS", "")),
+		AdminScheduleType:  getEnvFromFile(envMap, "ADMIN_SCHEDULE_TYPE", DefaultAdminScheduleType),
+		AdminScheduleDay:   getEnvFromFile(envMap, "ADMIN_SCHEDULE_DAY", DefaultAdminScheduleDay),
+		AdminScheduleTime:  getEnvFromFile(envMap, "ADMIN_SCHEDULE_TIME", DefaultAdminScheduleTime),
+		DiskWarningPercent: getEnvIntFromFile(envMap, "DISK_WARNING_PERCENT", DefaultDiskWarningPercent),
 		// Performance tuning - parse as integers with defaults
 		APIPageSize:     getEnvIntFromFile(envMap, "API_PAGE_SIZE", DefaultAPIPageSize),
 		MaxRetries:      getEnvIntFromFile(envMap, "MAX_RETRIES", DefaultMaxRetries),
@@ -120,6 +181,17 @@ func loadConfig() *Config {
 		WatchedSeriesHeading:      getEnvFromFile(envMap, "WATCHED_SERIES_HEADING", DefaultWatchedSeriesHeading),
 		AnticipatedMoviesHeading:  getEnvFromFile(envMap, "ANTICIPATED_MOVIES_HEADING", DefaultAnticipatedMoviesHeading),
 		WatchedMoviesHeading:      getEnvFromFile(envMap, "WATCHED_MOVIES_HEADING", DefaultWatchedMoviesHeading),
+		MostWatchedHeading:        getEnvFromFile(envMap, "MOST_WATCHED_HEADING", DefaultMostWatchedHeading),
+		RecentlyAddedHeading:      getEnvFromFile(envMap, "RECENTLY_ADDED_HEADING", DefaultRecentlyAddedHeading),
+		MusicHeading:              getEnvFromFile(envMap, "MUSIC_HEADING", DefaultMusicHeading),
+		BooksHeading:              getEnvFromFile(envMap, "BOOKS_HEADING", DefaultBooksHeading),
+		PendingRequestsHeading:    getEnvFromFile(envMap, "PENDING_REQUESTS_HEADING", DefaultPendingRequestsHeading),
+		ServerStatsHeading:        getEnvFromFile(envMap, "SERVER_STATS_HEADING", DefaultServerStatsHeading),
+		QueueHeading:              getEnvFromFile(envMap, "QUEUE_HEADING", DefaultQueueHeading),
+		MissingHeading:            getEnvFromFile(envMap, "MISSING_HEADING", DefaultMissingHeading),
+		TraktWatchlistHeading:     getEnvFromFile(envMap, "TRAKT_WATCHLIST_HEADING", DefaultTraktWatchlistHeading),
+		RecommendationsHeading:    getEnvFromFile(envMap, "RECOMMENDATIONS_HEADING", DefaultRecommendationsHeading),
+		TraktCalendarHeading:      getEnvFromFile(envMap, "TRAKT_CALENDAR_HEADING", DefaultTraktCalendarHeading),
 		FooterText:                getEnvFromFile(envMap, "FOOTER_TEXT", DefaultFooterText),
 		// Monthly email strings
 		MonthlyEmailTitle:                getEnvFromFile(envMap, "MONTHLY_EMAIL_TITLE", DefaultMonthlyEmailTitle),
@@ -137,6 +209,91 @@ func loadConfig() *Config {
 	}
 }
 
+// parseList splits a comma-separated list (email addresses, chat IDs), dropping empty entries
+func parseList(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// loadArrInstances reads the primary instance from PREFIX_URL/PREFIX_API_KEY/PREFIX_NAME
+// followed by any additional instances from PREFIX_2_* through PREFIX_<MaxArrInstances>_*.
+// Partially configured instances are kept so validation and health checks can report them.
+func loadArrInstances(envMap map[string]string, prefix, defaultName string) []ArrInstance {
+	instances := []ArrInstance{}
+
+	primary := ArrInstance{
+		Name:   getEnvFromFileOnly(envMap, prefix+"_NAME", defaultName),
+		URL:    strings.TrimSuffix(getEnvFromFileOnly(envMap, prefix+"_URL", ""), "/"),
+		APIKey: getEnvFromFileOnly(envMap, prefix+"_API_KEY", ""),
+	}
+	if primary.Name == "" {
+		primary.Name = defaultName
+	}
+	if primary.URL != "" || primary.APIKey != "" {
+		instances = append(instances, primary)
+	}
+
+	return append(instances, loadAdditionalArrInstances(envMap, prefix, defaultName)...)
+}
+
+// loadAdditionalArrInstances reads the numbered PREFIX_N_NAME/PREFIX_N_URL/PREFIX_N_API_KEY instances
+func loadAdditionalArrInstances(envMap map[string]string, prefix, defaultName string) []ArrInstance {
+	instances := []ArrInstance{}
+	for n := 2; n <= MaxArrInstances; n++ {
+		key := fmt.Sprintf("%s_%d", prefix, n)
+		inst := ArrInstance{
+			Name:   getEnvFromFileOnly(envMap, key+"_NAME", fmt.Sprintf("%s %d", defaultName, n)),
+			URL:    strings.TrimSuffix(getEnvFromFileOnly(envMap, key+"_URL", ""), "/"),
+			APIKey: getEnvFromFileOnly(envMap, key+"_API_KEY", ""),
+		}
+		if inst.Name == "" {
+			inst.Name = fmt.Sprintf("%s %d", defaultName, n)
+		}
+		if inst.URL != "" || inst.APIKey != "" {
+			instances = append(instances, inst)
+		}
+	}
+
+	return instances
+}
+
+// loadTraktLists reads the custom list sections from TRAKT_LIST_N_URL/TRAKT_LIST_N_HEADING/TRAKT_LIST_N_LIMIT
+func loadTraktLists(envMap map[string]string) []TraktList {
+	lists := []TraktList{}
+	for n := 1; n <= MaxTraktLists; n++ {
+		key := fmt.Sprintf("TRAKT_LIST_%d", n)
+		list := TraktList{
+			Heading: getEnvFromFile(envMap, key+"_HEADING", ""),
+			URL:     strings.TrimSuffix(getEnvFromFileOnly(envMap, key+"_URL", ""), "/"),
+			Limit:   getEnvIntFromFile(envMap, key+"_LIMIT", DefaultTraktListLimit),
+		}
+		if list.URL == "" {
+			continue
+		}
+		if list.Heading == "" {
+			list.Heading = fmt.Sprintf("Trakt list %d", n)
+		}
+		lists = append(lists, list)
+	}
+	return lists
+}
+
+// configuredInstances filters out instances that are missing a URL or API key
+func configuredInstances(instances []ArrInstance) []ArrInstance {
+	result := make([]ArrInstance, 0, len(instances))
+	for _, inst := range instances {
+		if inst.configured() {
+			result = append(result, inst)
+		}
+	}
+	return result
+}
+
 func readEnvFile() map[string]string {
 	envMap := make(map[string]string)
 
@@ -213,34 +370,158 @@ func validateConfig(cfg *Config) []string {
 		if len(cfg.ToEmails) == 0 {
 			warnings = append(warnings, "TO_EMAILS is not set - email sending will fail")
 		}
-		if cfg.SMTPUser == "" {
-			warnings = append(warnings, "SMTP_USER is not set - email sending may fail")
+		// SMTP_USER is optional for relays that accept mail without authentication
+		if cfg.SMTPUser != "" && cfg.SMTPPass == "" {
+			warnings = append(warnings, "SMTP_USER is set but SMTP_PASS is not - email sending may fail")
+		}
+		if mode, err := smtpSecurityMode(cfg); err != nil {
+			warnings = append(warnings, err.Error()+" - email sending will fail")
+		} else if mode == smtpSecurityNone && cfg.SMTPUser != "" {
+			warnings = append(warnings, "SMTP_SECURITY=none doesn't send credentials unencrypted, clear SMTP_USER for a relay without authentication - email sending will fail")
 		}
-		if cfg.SMTPPass == "" {
-			warnings = append(warnings, "SMTP_PASS is not set - email sending may fail")
+		if cfg.SMTPTLSSkipVerify {
+			warnings = append(warnings, "SMTP_TLS_SKIP_VERIFY is enabled - the SMTP server certificate is not verified")
 		}
 	}
 
 	// Check for API configuration (warn if none configured)
-	hasSonarr := cfg.SonarrURL != "" && cfg.SonarrAPIKey != ""
-	hasRadarr := cfg.RadarrURL != "" && cfg.RadarrAPIKey != ""
+	hasSonarr := len(configuredInstances(cfg.SonarrInstances)) > 0
+	hasRadarr := len(configuredInstances(cfg.RadarrInstances)) > 0
+	hasReadarr := len(configuredInstances(cfg.ReadarrInstances)) > 0
+	hasLidarr := cfg.LidarrURL != "" && cfg.LidarrAPIKey != ""
 
-	if !hasSonarr && !hasRadarr {
+	if !hasSonarr && !hasRadarr && !hasReadarr && !hasLidarr {
 		warnings = append(warnings, "Neither Sonarr nor Radarr is configured - newsletter will have no content")
 	}
 
 	// Warn about partial configuration
-	if cfg.SonarrURL != "" && cfg.SonarrAPIKey == "" {
-		warnings = append(warnings, "SONARR_URL is set but SONARR_API_KEY is missing")
+	allInstances := append(append(append([]ArrInstance{}, cfg.SonarrInstances...), cfg.RadarrInstances...), cfg.ReadarrInstances...)
+	for _, inst := range allInstances {
+		if inst.URL != "" && inst.APIKey == "" {
+			warnings = append(warnings, fmt.Sprintf("%s: URL is set but API key is missing", inst.Name))
+		}
+		if inst.APIKey != "" && inst.URL == "" {
+			warnings = append(warnings, fmt.Sprintf("%s: API key is set but URL is missing", inst.Name))
+		}
 	}
-	if cfg.SonarrAPIKey != "" && cfg.SonarrURL == "" {
-		warnings = append(warnings, "SONARR_API_KEY is set but SONARR_URL is missing")
+
+	// Warn about partial Jellyfin/Emby configuration
+	if cfg.JellyfinURL != "" && cfg.JellyfinAPIKey == "" {
+		warnings = append(warnings, "JELLYFIN_URL is set but JELLYFIN_API_KEY is missing")
+	}
+	if cfg.JellyfinAPIKey != "" && cfg.JellyfinURL == "" {
+		warnings = append(warnings, "JELLYFIN_API_KEY is set but JELLYFIN_URL is missing")
+	}
+
+	// Warn about partial Lidarr configuration
+	if cfg.LidarrURL != "" && cfg.LidarrAPIKey == "" {
+		warnings = append(warnings, "LIDARR_URL is set but LIDARR_API_KEY is missing")
 	}
-	if cfg.RadarrURL != "" && cfg.RadarrAPIKey == "" {
-		warnings = append(warnings, "RADARR_URL is set but RADARR_API_KEY is missing")
+	if cfg.LidarrAPIKey != "" && cfg.LidarrURL == "" {
+		warnings = append(warnings, "LIDARR_API_KEY is set but LIDARR_URL is missing")
 	}
-	if cfg.RadarrAPIKey != "" && cfg.RadarrURL == "" {
-		warnings = append(warnings, "RADARR_API_KEY is set but RADARR_URL is missing")
+
+	// Warn about partial Plex configuration
+	if cfg.PlexURL != "" && cfg.PlexToken == "" {
+		warnings = append(warnings, "PLEX_URL is set but PLEX_TOKEN is missing")
+	}
+	if cfg.PlexToken != "" && cfg.PlexURL == "" {
+		warnings = append(warnings, "PLEX_TOKEN is set but PLEX_URL is missing")
+	}
+
+	// Warn about partial Overseerr/Jellyseerr configuration
+	if cfg.OverseerrURL != "" && cfg.OverseerrAPIKey == "" {
+		warnings = append(warnings, "OVERSEERR_URL is set but OVERSEERR_API_KEY is missing")
+	}
+	if cfg.OverseerrAPIKey != "" && cfg.OverseerrURL == "" {
+		warnings = append(warnings, "OVERSEERR_API_KEY is set but OVERSEERR_URL is missing")
+	}
+
+	// Warn about partial Tautulli configuration
+	if cfg.TautulliURL != "" && cfg.TautulliAPIKey == "" {
+		warnings = append(warnings, "TAUTULLI_URL is set but TAUTULLI_API_KEY is missing")
+	}
+	if cfg.TautulliAPIKey != "" && cfg.TautulliURL == "" {
+		warnings = append(warnings, "TAUTULLI_API_KEY is set but TAUTULLI_URL is missing")
+	}
+
+	// Warn about custom Trakt lists that can't be used
+	for _, list := range cfg.TraktLists {
+		if _, err := traktListPath(list.URL); err != nil {
+			warnings = append(warnings, err.Error())
+		} else if cfg.TraktClientID == "" {
+			warnings = append(warnings, fmt.Sprintf("Trakt list '%s' is configured but TRAKT_CLIENT_ID is missing", list.Heading))
+		}
+	}
+
+	// Warn about a Slack webhook that isn't a webhook URL
+	if cfg.SlackWebhookURL != "" && !strings.HasPrefix(cfg.SlackWebhookURL, "https://") && !strings.HasPrefix(cfg.SlackWebhookURL, "http://") {
+		warnings = append(warnings, "SLACK_WEBHOOK_URL should be an http(s):// webhook URL - Slack delivery will fail")
+	}
+
+	// Warn about a Discord webhook that isn't a webhook URL
+	if cfg.DiscordWebhookURL != "" && !strings.HasPrefix(cfg.DiscordWebhookURL, "https://") {
+		warnings = append(warnings, "DISCORD_WEBHOOK_URL should be an https:// webhook URL - Discord delivery will fail")
+	}
+
+	// Warn about partial Telegram configuration
+	if cfg.TelegramBotToken != "" && len(cfg.TelegramChatIDs) == 0 {
+		warnings = append(warnings, "TELEGRAM_BOT_TOKEN is set but TELEGRAM_CHAT_IDS is missing")
+	}
+	if cfg.TelegramBotToken == "" && len(cfg.TelegramChatIDs) > 0 {
+		warnings = append(warnings, "TELEGRAM_CHAT_IDS is set but TELEGRAM_BOT_TOKEN is missing")
+	}
+
+	// Warn about partial Matrix configuration
+	matrixFields := 0
+	for _, v := range []string{cfg.MatrixHomeserverURL, cfg.MatrixAccessToken, cfg.MatrixRoomID} {
+		if v != "" {
+			matrixFields++
+		}
+	}
+	if matrixFields > 0 && matrixFields < 3 {
+		warnings = append(warnings, "Matrix needs MATRIX_HOMESERVER_URL, MATRIX_ACCESS_TOKEN and MATRIX_ROOM_ID - Matrix notifications are disabled")
+	}
+
+	// Warn about partial Gotify configuration
+	if cfg.GotifyURL != "" && cfg.GotifyToken == "" {
+		warnings = append(warnings, "GOTIFY_URL is set but GOTIFY_TOKEN is missing")
+	}
+	if cfg.GotifyToken != "" && cfg.GotifyURL == "" {
+		warnings = append(warnings, "GOTIFY_TOKEN is set but GOTIFY_URL is missing")
+	}
+
+	// Unsigned webhooks can't be verified by the receiver
+	if cfg.WebhookURL != "" && cfg.WebhookSecret == "" {
+		warnings = append(warnings, "WEBHOOK_URL is set but WEBHOOK_SECRET is missing - webhook requests won't be signed")
+	}
+
+	// Push notifications link to the archive, which needs a reachable URL
+	hasPush := matrixFields == 3 || cfg.NtfyTopic != "" || (cfg.GotifyURL != "" && cfg.GotifyToken != "")
+	if hasPush && cfg.PublicURL == "" {
+		warnings = append(warnings, "PUBLIC_URL is not set - push notifications won't link to the newsletter archive")
+	}
+
+	// Warn about partial Bazarr configuration
+	if cfg.BazarrURL != "" && cfg.BazarrAPIKey == "" {
+		warnings = append(warnings, "BAZARR_URL is set but BAZARR_API_KEY is missing")
+	}
+	if cfg.BazarrAPIKey != "" && cfg.BazarrURL == "" {
+		warnings = append(warnings, "BAZARR_API_KEY is set but BAZARR_URL is missing")
+	}
+
+	// Warn about an admin digest with nothing to check
+	if len(cfg.AdminEmails) > 0 && len(adminTargets(cfg)) == 0 {
+		warnings = append(warnings, "ADMIN_EMAILS is set but no Sonarr, Radarr, Readarr or Lidarr instance is configured - the admin digest will be empty")
+	}
+
+	// Warn about duplicate instance names (labels would be ambiguous in the newsletter)
+	seenNames := make(map[string]bool)
+	for _, inst := range allInstances {
+		if seenNames[inst.Name] {
+			warnings = append(warnings, fmt.Sprintf("Instance name '%s' is used more than once", inst.Name))
+		}
+		seenNames[inst.Name] = true
 	}
 
 	// Validate timezone
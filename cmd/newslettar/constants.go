@@ -7,6 +7,8 @@ const (
 	// SMTP defaults (Mailgun as reasonable default, but works with any SMTP provider)
 	DefaultSMTPHost                   = "smtp.mailgun.org"
 	DefaultSMTPPort                   = "587"
+	DefaultSMTPSecurity               = "starttls-opportunistic"
+	DefaultSMTPTLSSkipVerify          = "false"
 	DefaultFromName                   = "Newslettar"
 	DefaultTimezone                   = "UTC"
 	DefaultScheduleDay                = "Sun"
@@ -24,6 +26,21 @@ const (
 	DefaultShowTraktWatchedSeries     = "false"
 	DefaultShowTraktAnticipatedMovies = "false"
 	DefaultShowTraktWatchedMovies     = "false"
+	DefaultShowServerMostWatched      = "true"
+	DefaultShowServerRecentlyAdded    = "true"
+	DefaultShowMusic                  = "true"
+	DefaultShowBooks                  = "true"
+	DefaultShowPendingRequests        = "true"
+	DefaultShowServerStats            = "true"
+	DefaultShowSubtitles              = "true"
+	DefaultShowQueue                  = "true"
+	DefaultShowMissing                = "false"
+	DefaultShowTraktWatchlist         = "true"
+	DefaultShowTraktRecommendations   = "true"
+	DefaultShowTraktCalendar          = "true"
+	DefaultFeedItems                  = "false"
+	DefaultEmailAttachICS             = "false"
+	DefaultEmailInlinePosters         = "false"
 )
 
 // API and performance defaults
@@ -38,6 +55,56 @@ const (
 	DefaultIdleConnTimeout     = 90 * time.Second
 	DefaultEmailBatchSize      = 10
 	DefaultEmailBatchDelay     = 1 * time.Second
+	DefaultNotifyTimeout       = 5 * time.Minute // Deadline for delivering one newsletter to all notifiers
+	DefaultQueueLimit          = 10
+	DefaultMissingLimit        = 20
+)
+
+// Multi-instance defaults
+const (
+	DefaultSonarrName  = "Sonarr"
+	DefaultRadarrName  = "Radarr"
+	DefaultReadarrName = "Readarr"
+	MaxArrInstances    = 10 // Highest N scanned for SONARR_N_* / RADARR_N_* / READARR_N_* keys
+)
+
+// Media server (Jellyfin/Emby) defaults
+const (
+	DefaultJellyfinServerType       = "jellyfin" // "jellyfin" or "emby"
+	DefaultServerMostWatchedLimit   = 10
+	DefaultServerRecentlyAddedLimit = 10
+)
+
+// Notifier defaults
+const (
+	DefaultTelegramAPIURL = "https://api.telegram.org" // Override to point the bot at a local Bot API server
+	DefaultNtfyURL        = "https://ntfy.sh"
+)
+
+// Trakt personal and custom list section defaults
+const (
+	DefaultTraktRecommendationsLimit = 5
+	DefaultTraktCalendarLimit        = 10
+	DefaultTraktListLimit            = 10
+	MaxTraktLists                    = 10 // Highest N scanned for TRAKT_LIST_N_* keys
+)
+
+// Tautulli defaults
+const (
+	DefaultServerStatsLimit = 5
+)
+
+// Request manager (Overseerr/Jellyseerr) defaults
+const (
+	DefaultPendingRequestsLimit = 10
+)
+
+// Admin digest defaults
+const (
+	DefaultAdminScheduleType  = "daily" // "daily" or "weekly"
+	DefaultAdminScheduleDay   = "Mon"
+	DefaultAdminScheduleTime  = "08:00"
+	DefaultDiskWarningPercent = 10 // Flag disks with less free space than this
 )
 
 // Log configuration
@@ -49,6 +116,13 @@ const (
 // Server configuration
 const (
 	DefaultWebUIPort = "8080"
+	FeedIssueLimit   = 20 // Newest archived issues published in the RSS/Atom feeds
+)
+
+// Calendar feed window (/calendar.ics)
+const (
+	CalendarFeedPastDays = 7
+	CalendarFeedDays     = 60
 )
 
 // Email string defaults (weekly schedule)
@@ -69,6 +143,17 @@ const (
 	DefaultWatchedSeriesHeading      = "Most Watched Series (Last Week)"
 	DefaultAnticipatedMoviesHeading  = "Most Anticipated Movies (Next Week)"
 	DefaultWatchedMoviesHeading      = "Most Watched Movies (Last Week)"
+	DefaultMostWatchedHeading        = "Most watched on our server"
+	DefaultRecentlyAddedHeading      = "Recently added"
+	DefaultMusicHeading              = "Music"
+	DefaultBooksHeading              = "Books"
+	DefaultPendingRequestsHeading    = "Pending requests"
+	DefaultServerStatsHeading        = "Server stats"
+	DefaultQueueHeading              = "Downloading now"
+	DefaultMissingHeading            = "Missing"
+	DefaultTraktWatchlistHeading     = "From your watchlist: now available"
+	DefaultRecommendationsHeading    = "Recommended for you"
+	DefaultTraktCalendarHeading      = "On your Trakt calendar"
 	DefaultFooterText                = "Generated by Newslettar"
 )
 
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Discord renders the newsletter as webhook messages with one rich embed per series group or movie.
+// Webhooks accept at most 10 embeds and 6000 embed characters per message, so every section
+// starts a new message and is split into as many messages as needed.
+
+const (
+	discordMaxEmbeds      = 10   // Embeds per message
+	discordMaxEmbedChars  = 6000 // Combined title/description/field/footer characters per message
+	discordMaxContent     = 2000 // Message content
+	discordMaxTitle       = 256
+	discordMaxDescription = 4096
+	discordMaxFieldValue  = 1024
+	discordMaxFooter      = 2048
+
+	discordColorUpcoming   = 0x667eea
+	discordColorDownloaded = 0x38ef7d
+)
+
+type discordEmbedImage struct {
+	URL string `json:"url"`
+}
+
+type discordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+type discordEmbedFooter struct {
+	Text string `json:"text"`
+}
+
+type discordEmbed struct {
+	Title       string              `json:"title,omitempty"`
+	URL         string              `json:"url,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Color       int                 `json:"color,omitempty"`
+	Thumbnail   *discordEmbedImage  `json:"thumbnail,omitempty"`
+	Fields      []discordEmbedField `json:"fields,omitempty"`
+	Footer      *discordEmbedFooter `json:"footer,omitempty"`
+}
+
+type discordMessage struct {
+	Username string         `json:"username,omitempty"`
+	Content  string         `json:"content,omitempty"`
+	Embeds   []discordEmbed `json:"embeds,omitempty"`
+}
+
+// discordSection is a heading followed by its embeds
+type discordSection struct {
+	Heading string
+	Embeds  []discordEmbed
+}
+
+// discordNotifier posts the newsletter to a Discord channel webhook
+type discordNotifier struct {
+	cfg *Config
+}
+
+func (n discordNotifier) Name() string { return "discord" }
+
+func (n discordNotifier) Send(ctx context.Context, issue *NewsletterIssue) error {
+	messages := buildDiscordMessages(issue.Data)
+	for i := range messages {
+		messages[i].Username = n.cfg.FromName
+		if err := postDiscordWebhook(ctx, n.cfg.DiscordWebhookURL, messages[i]); err != nil {
+			return fmt.Errorf("message %d/%d: %w", i+1, len(messages), err)
+		}
+	}
+	return nil
+}
+
+// discordThumbnail returns the poster as an embed thumbnail
+func discordThumbnail(data NewsletterData, posterURL string) *discordEmbedImage {
+	if posterURL = publicPosterURL(data, posterURL); posterURL == "" {
+		return nil
+	}
+	return &discordEmbedImage{URL: posterURL}
+}
+
+// discordCommonFields returns the rating, requester and instance fields shared by series and movies
+func discordCommonFields(data NewsletterData, rating float64, requestedBy, instances []string) []discordEmbedField {
+	var fields []discordEmbedField
+	if data.ShowSeriesRatings && rating > 0 {
+		fields = append(fields, discordEmbedField{Name: "Rating", Value: fmt.Sprintf("⭐ %.1f/10", rating), Inline: true})
+	}
+	if len(requestedBy) > 0 {
+		fields = append(fields, discordEmbedField{Name: "Requested by", Value: truncateRunes(strings.Join(requestedBy, ", "), discordMaxFieldValue), Inline: true})
+	}
+	if data.ShowInstanceLabels && len(instances) > 0 {
+		fields = append(fields, discordEmbedField{Name: "Instance", Value: truncateRunes(strings.Join(instances, ", "), discordMaxFieldValue), Inline: true})
+	}
+	return fields
+}
+
+// discordSeriesEmbed renders a series group with one line per episode and its air date
+func discordSeriesEmbed(data NewsletterData, group SeriesGroup, color int) discordEmbed {
+	embed := discordEmbed{
+		Title:     truncateRunes(group.SeriesTitle, discordMaxTitle),
+		Color:     color,
+		Thumbnail: discordThumbnail(data, group.PosterURL),
+		Fields:    discordCommonFields(data, group.SeriesRating, group.RequestedBy, group.Instances),
+	}
+	if group.IMDBID != "" {
+		embed.URL = "https://www.imdb.com/title/" + group.IMDBID + "/"
+	}
+
+	var lines []string
+	if data.ShowSeriesOverview && group.Overview != "" {
+		lines = append(lines, truncateRunes(group.Overview, 300), "")
+	}
+	for i, ep := range group.Episodes {
+		title := ep.Title
+		if title == "" {
+			title = fmt.Sprintf("Episode %d", ep.EpisodeNum)
+		}
+		line := fmt.Sprintf("**S%02dE%02d** %s · %s", ep.SeasonNum, ep.EpisodeNum, title, formatDateWithDay(ep.AirDate))
+		// Leave room for the "more episodes" line
+		if len([]rune(strings.Join(append(lines, line), "\n"))) > discordMaxDescription-40 {
+			lines = append(lines, fmt.Sprintf("… and %d more episodes", len(group.Episodes)-i))
+			break
+		}
+		lines = append(lines, line)
+	}
+	embed.Description = strings.Join(lines, "\n")
+	return embed
+}
+
+// discordMovieEmbed renders a movie with its release date
+func discordMovieEmbed(data NewsletterData, movie Movie, color int) discordEmbed {
+	title := movie.Title
+	if movie.Year > 0 {
+		title = fmt.Sprintf("%s (%d)", movie.Title, movie.Year)
+	}
+	embed := discordEmbed{
+		Title:     truncateRunes(title, discordMaxTitle),
+		Color:     color,
+		Thumbnail: discordThumbnail(data, movie.PosterURL),
+	}
+	if movie.IMDBID != "" {
+		embed.URL = "https://www.imdb.com/title/" + movie.IMDBID + "/"
+	}
+	if data.ShowSeriesOverview && movie.Overview != "" {
+		embed.Description = truncateRunes(movie.Overview, 300)
+	}
+	if movie.ReleaseDate != "" {
+		embed.Fields = append(embed.Fields, discordEmbedField{Name: "Release date", Value: formatDateWithDay(movie.ReleaseDate), Inline: true})
+	}
+	embed.Fields = append(embed.Fields, discordCommonFields(data, movie.Rating, movie.RequestedBy, movie.Instances)...)
+	return embed
+}
+
+// discordEmbedLength counts the characters Discord applies to the per-message embed limit
+func discordEmbedLength(embed discordEmbed) int {
+	n := len([]rune(embed.Title)) + len([]rune(embed.Description))
+	for _, f := range embed.Fields {
+		n += len([]rune(f.Name)) + len([]rune(f.Value))
+	}
+	if embed.Footer != nil {
+		n += len([]rune(embed.Footer.Text))
+	}
+	return n
+}
+
+// discordSections groups the newsletter into upcoming and downloaded series/movie sections
+func discordSections(data NewsletterData) []discordSection {
+	var sections []discordSection
+	addSeries := func(heading string, groups []SeriesGroup, color int) {
+		if len(groups) == 0 {
+			return
+		}
+		section := discordSection{Heading: heading}
+		for _, group := range groups {
+			section.Embeds = append(section.Embeds, discordSeriesEmbed(data, group, color))
+		}
+		sections = append(sections, section)
+	}
+	addMovies := func(heading string, movies []Movie, color int) {
+		if len(movies) == 0 {
+			return
+		}
+		section := discordSection{Heading: heading}
+		for _, movie := range movies {
+			section.Embeds = append(section.Embeds, discordMovieEmbed(data, movie, color))
+		}
+		sections = append(sections, section)
+	}
+
+	addSeries("📅 "+data.ComingThisWeekHeading+" · "+data.TVShowsHeading, data.UpcomingSeriesGroups, discordColorUpcoming)
+	addMovies("📅 "+data.ComingThisWeekHeading+" · "+data.MoviesHeading, data.UpcomingMovies, discordColorUpcoming)
+	if data.ShowDownloaded {
+		addSeries("📥 "+data.DownloadedSectionHeading+" · "+data.TVShowsHeading, data.DownloadedSeriesGroups, discordColorDownloaded)
+		addMovies("📥 "+data.DownloadedSectionHeading+" · "+data.MoviesHeading, data.DownloadedMovies, discordColorDownloaded)
+	}
+	return sections
+}
+
+// buildDiscordMessages renders the newsletter as an intro message followed by the
+// section messages, chunked to respect Discord's embed count and size limits
+func buildDiscordMessages(data NewsletterData) []discordMessage {
+	intro := fmt.Sprintf("## %s\n%s %s - %s", data.EmailTitle, data.WeekRangePrefix, data.UpcomingStart, data.UpcomingEnd)
+	if data.EmailIntro != "" {
+		intro += "\n\n" + data.EmailIntro
+	}
+	messages := []discordMessage{{Content: truncateRunes(intro, discordMaxContent)}}
+
+	for _, section := range discordSections(data) {
+		msg := discordMessage{Content: "### " + section.Heading}
+		chars := 0
+		for _, embed := range section.Embeds {
+			length := discordEmbedLength(embed)
+			if len(msg.Embeds) == discordMaxEmbeds || (len(msg.Embeds) > 0 && chars+length > discordMaxEmbedChars) {
+				messages = append(messages, msg)
+				msg, chars = discordMessage{}, 0
+			}
+			msg.Embeds = append(msg.Embeds, embed)
+			chars += length
+		}
+		messages = append(messages, msg)
+	}
+
+	if data.FooterText != "" && len(messages) > 1 {
+		// Footer on the last embed of the newsletter, when it still fits
+		last := &messages[len(messages)-1]
+		embed := &last.Embeds[len(last.Embeds)-1]
+		if embed.Footer == nil {
+			embed.Footer = &discordEmbedFooter{Text: truncateRunes(data.FooterText, discordMaxFooter)}
+		}
+		total := 0
+		for _, e := range last.Embeds {
+			total += discordEmbedLength(e)
+		}
+		if total > discordMaxEmbedChars {
+			embed.Footer = nil
+		}
+	}
+	return messages
+}
+
+// postDiscordWebhook posts one message, waiting and retrying when Discord rate limits the webhook
+func postDiscordWebhook(ctx context.Context, webhookURL string, msg discordMessage) error {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL: %w", err)
+	}
+	q := u.Query()
+	q.Set("wait", "true") // Report errors instead of silently dropping invalid messages
+	u.RawQuery = q.Encode()
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, "POST", u.String(), bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return err
+		}
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		resp.Body.Close()
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return nil
+		}
+		if resp.StatusCode == http.StatusTooManyRequests && attempt < DefaultMaxRetries {
+			var rateLimit struct {
+				RetryAfter float64 `json:"retry_after"` // Seconds
+			}
+			json.Unmarshal(respBody, &rateLimit)
+			wait := time.Duration(rateLimit.RetryAfter*float64(time.Second)) + 100*time.Millisecond
+			select {
+			case <-time.After(wait):
+				continue
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return fmt.Errorf("webhook request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBuildDiscordMessages(t *testing.T) {
+	movies := func(n int, long bool) []Movie {
+		var movies []Movie
+		for i := 0; i < n; i++ {
+			movie := Movie{Title: fmt.Sprintf("Movie %d", i), TmdbID: i + 1}
+			if long {
+				// Each embed is then about 1600 characters, so only three fit in a message
+				movie.Title = strings.Repeat("T", 300)
+				movie.Overview = strings.Repeat("o", 400)
+				movie.RequestedBy = []string{strings.Repeat("r", 1100)}
+			}
+			movies = append(movies, movie)
+		}
+		return movies
+	}
+
+	tests := []struct {
+		name         string
+		data         NewsletterData
+		wantEmbeds   []int    // Embeds per message after the intro
+		wantContents []string // Content per message after the intro
+	}{
+		{
+			name: "nothing to report",
+			data: NewsletterData{},
+		},
+		{
+			name:         "one message per section",
+			data:         NewsletterData{UpcomingMovies: movies(2, false), DownloadedMovies: movies(3, false), ShowDownloaded: true},
+			wantEmbeds:   []int{2, 3},
+			wantContents: []string{"### 📅 Coming · Movies", "### 📥 Downloaded · Movies"},
+		},
+		{
+			name:         "downloaded section hidden",
+			data:         NewsletterData{UpcomingMovies: movies(2, false), DownloadedMovies: movies(3, false)},
+			wantEmbeds:   []int{2},
+			wantContents: []string{"### 📅 Coming · Movies"},
+		},
+		{
+			name:         "split at the embed count limit",
+			data:         NewsletterData{UpcomingMovies: movies(25, false)},
+			wantEmbeds:   []int{10, 10, 5},
+			wantContents: []string{"### 📅 Coming · Movies", "", ""},
+		},
+		{
+			name:         "split at the embed character limit",
+			data:         NewsletterData{UpcomingMovies: movies(7, true), ShowSeriesOverview: true},
+			wantEmbeds:   []int{3, 3, 1},
+			wantContents: []string{"### 📅 Coming · Movies", "", ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.data.EmailTitle = "Newsletter"
+			tt.data.ComingThisWeekHeading = "Coming"
+			tt.data.DownloadedSectionHeading = "Downloaded"
+			tt.data.MoviesHeading = "Movies"
+			messages := buildDiscordMessages(tt.data)
+
+			if len(messages[0].Embeds) != 0 || !strings.HasPrefix(messages[0].Content, "## Newsletter") {
+				t.Errorf("first message = %+v, want the intro", messages[0])
+			}
+			var embeds []int
+			var contents []string
+			for _, msg := range messages[1:] {
+				embeds = append(embeds, len(msg.Embeds))
+				contents = append(contents, msg.Content)
+
+				chars := 0
+				for _, embed := range msg.Embeds {
+					chars += discordEmbedLength(embed)
+				}
+				if len(msg.Embeds) > discordMaxEmbeds || chars > discordMaxEmbedChars {
+					t.Errorf("message with %d embeds and %d characters exceeds the Discord limits", len(msg.Embeds), chars)
+				}
+			}
+			if !reflect.DeepEqual(embeds, tt.wantEmbeds) {
+				t.Errorf("embeds per message = %v, want %v", embeds, tt.wantEmbeds)
+			}
+			if !reflect.DeepEqual(contents, tt.wantContents) {
+				t.Errorf("message contents = %q, want %q", contents, tt.wantContents)
+			}
+		})
+	}
+}
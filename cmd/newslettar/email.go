@@ -0,0 +1,290 @@
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+)
+
+// MIME assembly for outgoing email. The newsletter is sent as multipart/alternative
+// (plain text first, HTML last, so clients pick the richest part they can show),
+// wrapped in multipart/mixed when there are attachments. Inline images travel with
+// the HTML in a multipart/related part.
+
+// emailBody is the content of one email
+type emailBody struct {
+	HTML        string
+	Text        string // Optional plain-text alternative to the HTML
+	Attachments []emailAttachment
+}
+
+// emailAttachment is a file attached to the email (e.g. the .ics calendar)
+type emailAttachment struct {
+	Filename    string
+	ContentType string
+	ContentID   string // Set for inline images the HTML references as cid:<ContentID>
+	Data        []byte
+}
+
+// mimeEntity is an encoded MIME part: its headers and body
+type mimeEntity struct {
+	Header textproto.MIMEHeader
+	Body   []byte
+}
+
+// quotedPrintableEntity encodes text content as quoted-printable, which keeps lines under
+// the SMTP limit and survives 7-bit relays while staying readable for mostly-ASCII text
+func quotedPrintableEntity(contentType, content string) mimeEntity {
+	var buf bytes.Buffer
+	qp := quotedprintable.NewWriter(&buf)
+	qp.Write([]byte(content))
+	qp.Close()
+	return mimeEntity{
+		Header: textproto.MIMEHeader{
+			"Content-Type":              {contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		},
+		Body: buf.Bytes(),
+	}
+}
+
+// base64Entity encodes binary content as base64 in lines of 76 characters (RFC 2045)
+func base64Entity(header textproto.MIMEHeader, data []byte) mimeEntity {
+	header.Set("Content-Transfer-Encoding", "base64")
+	encoded := base64.StdEncoding.EncodeToString(data)
+	var buf bytes.Buffer
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded + "\r\n")
+	return mimeEntity{Header: header, Body: buf.Bytes()}
+}
+
+// multipartEntity nests parts in a multipart/<subtype> entity
+func multipartEntity(subtype string, parts ...mimeEntity) (mimeEntity, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	for _, part := range parts {
+		w, err := mw.CreatePart(part.Header)
+		if err != nil {
+			return mimeEntity{}, err
+		}
+		w.Write(part.Body)
+	}
+	if err := mw.Close(); err != nil {
+		return mimeEntity{}, err
+	}
+	return mimeEntity{
+		Header: textproto.MIMEHeader{"Content-Type": {"multipart/" + subtype + "; boundary=" + mw.Boundary()}},
+		Body:   buf.Bytes(),
+	}, nil
+}
+
+// buildEmailEntity assembles the MIME structure of an email body
+func buildEmailEntity(body emailBody) (mimeEntity, error) {
+	var inline, attached []mimeEntity
+	for _, att := range body.Attachments {
+		header := textproto.MIMEHeader{"Content-Type": {att.ContentType}}
+		if att.ContentID != "" {
+			header.Set("Content-ID", "<"+att.ContentID+">")
+			header.Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": att.Filename}))
+			inline = append(inline, base64Entity(header, att.Data))
+			continue
+		}
+		header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": att.Filename}))
+		attached = append(attached, base64Entity(header, att.Data))
+	}
+
+	var err error
+	entity := quotedPrintableEntity("text/html; charset=UTF-8", body.HTML)
+	if len(inline) > 0 {
+		entity, err = multipartEntity("related", append([]mimeEntity{entity}, inline...)...)
+		if err != nil {
+			return mimeEntity{}, err
+		}
+	}
+	if body.Text != "" {
+		entity, err = multipartEntity("alternative", quotedPrintableEntity("text/plain; charset=UTF-8", body.Text), entity)
+		if err != nil {
+			return mimeEntity{}, err
+		}
+	}
+
+	if len(attached) == 0 {
+		return entity, nil
+	}
+	return multipartEntity("mixed", append([]mimeEntity{entity}, attached...)...)
+}
+
+// SMTP_SECURITY modes
+const (
+	smtpSecurityStartTLSRequired      = "starttls-required"
+	smtpSecurityStartTLSOpportunistic = "starttls-opportunistic"
+	smtpSecurityImplicitTLS           = "implicit-tls"
+	smtpSecurityNone                  = "none"
+)
+
+// smtpSecurityMode resolves SMTP_SECURITY, defaulting to starttls-opportunistic
+func smtpSecurityMode(cfg *Config) (string, error) {
+	switch mode := strings.ToLower(strings.TrimSpace(cfg.SMTPSecurity)); mode {
+	case "":
+		return DefaultSMTPSecurity, nil
+	case smtpSecurityStartTLSRequired, smtpSecurityStartTLSOpportunistic, smtpSecurityImplicitTLS, smtpSecurityNone:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown SMTP_SECURITY %q (use starttls-required, starttls-opportunistic, implicit-tls or none)", cfg.SMTPSecurity)
+	}
+}
+
+// smtpTLSConfig requires TLS 1.2+ with strong ciphers, trusting SMTP_CA_FILE in addition
+// to the system roots for self-hosted relays with their own CA
+func smtpTLSConfig(cfg *Config) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName: cfg.SMTPHost,
+		MinVersion: tls.VersionTLS12,
+		CipherSuites: []uint16{
+			tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+			tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+			tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+			tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+		},
+		InsecureSkipVerify: cfg.SMTPTLSSkipVerify,
+	}
+	if cfg.SMTPCAFile != "" {
+		pem, err := os.ReadFile(cfg.SMTPCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read SMTP_CA_FILE: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in SMTP_CA_FILE %s", cfg.SMTPCAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+// dialSMTP connects to the SMTP server with the configured security mode and authenticates
+// when SMTP_USER is set. It returns the client and a description of the connection security.
+func dialSMTP(cfg *Config) (*smtp.Client, string, error) {
+	mode, err := smtpSecurityMode(cfg)
+	if err != nil {
+		return nil, "", err
+	}
+	if mode == smtpSecurityNone && cfg.SMTPUser != "" {
+		return nil, "", fmt.Errorf("SMTP_SECURITY=none can't be used with SMTP_USER, the password would be sent unencrypted")
+	}
+	var tlsConfig *tls.Config
+	if mode != smtpSecurityNone {
+		if tlsConfig, err = smtpTLSConfig(cfg); err != nil {
+			return nil, "", err
+		}
+	}
+
+	addr := net.JoinHostPort(cfg.SMTPHost, cfg.SMTPPort)
+	var client *smtp.Client
+	if mode == smtpSecurityImplicitTLS {
+		conn, err := tls.Dial("tcp", addr, tlsConfig)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to connect to SMTP server: %w", err)
+		}
+		if client, err = smtp.NewClient(conn, cfg.SMTPHost); err != nil {
+			conn.Close()
+			return nil, "", fmt.Errorf("failed to connect to SMTP server: %w", err)
+		}
+	} else if client, err = smtp.Dial(addr); err != nil {
+		return nil, "", fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+
+	if err = client.Hello("localhost"); err != nil {
+		client.Close()
+		return nil, "", fmt.Errorf("EHLO failed: %w", err)
+	}
+
+	security := "implicit TLS"
+	switch mode {
+	case smtpSecurityNone:
+		security = "no encryption"
+	case smtpSecurityStartTLSRequired, smtpSecurityStartTLSOpportunistic:
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err = client.StartTLS(tlsConfig); err != nil {
+				client.Close()
+				return nil, "", fmt.Errorf("STARTTLS failed: %w", err)
+			}
+			security = "STARTTLS"
+		} else if mode == smtpSecurityStartTLSRequired {
+			client.Close()
+			return nil, "", fmt.Errorf("server does not offer STARTTLS (SMTP_SECURITY=%s)", mode)
+		} else {
+			security = "no encryption, server does not offer STARTTLS"
+		}
+	}
+
+	if cfg.SMTPUser != "" {
+		auth := smtp.PlainAuth("", cfg.SMTPUser, cfg.SMTPPass, cfg.SMTPHost)
+		if err = client.Auth(auth); err != nil {
+			client.Close()
+			return nil, "", fmt.Errorf("authentication failed: %w", err)
+		}
+	}
+	return client, security, nil
+}
+
+// Plain-text rendering helpers for templates/email.txt
+
+// textHeading underlines a heading with the given character, e.g. "Movies\n======"
+func textHeading(s, underline string) string {
+	return s + "\n" + strings.Repeat(underline, utf8.RuneCountInString(s))
+}
+
+// textWrap word-wraps s at 72 columns, indenting every line by indent spaces
+func textWrap(indent int, s string) string {
+	const width = 72
+	prefix := strings.Repeat(" ", indent)
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(s) {
+		if line != "" && utf8.RuneCountInString(prefix+line+" "+word) > width {
+			lines = append(lines, prefix+line)
+			line = word
+			continue
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		lines = append(lines, prefix+line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// initEmailTextTemplate compiles the plain-text newsletter template
+func initEmailTextTemplate() (*template.Template, error) {
+	return template.New("email.txt").Funcs(template.FuncMap{
+		"formatDateWithDay": formatDateWithDay,
+		"truncate":          truncateString,
+		"join":              strings.Join,
+		"sub":               func(a, b int) int { return a - b },
+		"add":               func(a, b int) int { return a + b },
+		"heading":           textHeading,
+		"wrap":              textWrap,
+	}).ParseFS(templateFS, "templates/email.txt")
+}
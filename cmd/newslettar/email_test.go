@@ -0,0 +1,46 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSMTPSecurityMode(t *testing.T) {
+	tests := []struct {
+		security string
+		want     string
+		wantErr  bool
+	}{
+		{security: "", want: smtpSecurityStartTLSOpportunistic},
+		{security: "starttls-required", want: smtpSecurityStartTLSRequired},
+		{security: "starttls-opportunistic", want: smtpSecurityStartTLSOpportunistic},
+		{security: "implicit-tls", want: smtpSecurityImplicitTLS},
+		{security: "none", want: smtpSecurityNone},
+		{security: " Implicit-TLS ", want: smtpSecurityImplicitTLS},
+		{security: "auto", wantErr: true},
+		{security: "tls", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.security, func(t *testing.T) {
+			got, err := smtpSecurityMode(&Config{SMTPSecurity: tt.security, SMTPPort: "465"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("smtpSecurityMode(%q) error = %v, wantErr %v", tt.security, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("smtpSecurityMode(%q) = %q, want %q", tt.security, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDialSMTPRefusesCredentialsWithoutEncryption(t *testing.T) {
+	// Port 1 is never dialed, the configuration is refused first
+	cfg := &Config{SMTPHost: "127.0.0.1", SMTPPort: "1", SMTPSecurity: "none", SMTPUser: "user", SMTPPass: "pass"}
+	client, _, err := dialSMTP(cfg)
+	if client != nil {
+		client.Close()
+	}
+	if err == nil || !strings.Contains(err.Error(), "SMTP_SECURITY=none") {
+		t.Errorf("dialSMTP() error = %v, want the SMTP_SECURITY=none refusal", err)
+	}
+}
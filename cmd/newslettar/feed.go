@@ -0,0 +1,282 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html/template"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// The Atom (/feed.xml) and RSS (/rss.xml) feeds publish every archived newsletter as an entry,
+// and with FEED_ITEMS=true also every downloaded series and movie with its poster as enclosure.
+
+// feedEntry is one feed entry, shared by the Atom and RSS renderers
+type feedEntry struct {
+	ID        string
+	Title     string
+	Link      string
+	Summary   string
+	HTML      string
+	Published time.Time
+	PosterURL string
+}
+
+// feedBaseURL returns PUBLIC_URL, or the URL the request came in on when it isn't set
+func feedBaseURL(cfg *Config, r *http.Request) string {
+	if cfg.PublicURL != "" {
+		return cfg.PublicURL
+	}
+	scheme := "http"
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+// feedTitle names the feed after the newsletter sender
+func feedTitle(cfg *Config) string {
+	if cfg.FromName != "" {
+		return cfg.FromName
+	}
+	return DefaultFromName
+}
+
+// buildFeedEntries returns the entries of the newest archived issues, newest first
+func buildFeedEntries(cfg *Config, baseURL string) []feedEntry {
+	ids := listArchive()
+	if len(ids) > FeedIssueLimit {
+		ids = ids[:FeedIssueLimit]
+	}
+
+	entries := []feedEntry{}
+	for _, id := range ids {
+		meta, err := loadArchiveMeta(id)
+		if err != nil {
+			log.Printf("⚠️  Skipping archived issue %s in feed: %v", id, err)
+			continue
+		}
+		link := baseURL + "/archive/" + id
+		summary := meta.Summary
+		if summary == "" {
+			summary = meta.Subject
+		}
+		entries = append(entries, feedEntry{
+			ID:        link,
+			Title:     meta.Subject,
+			Link:      link,
+			Summary:   summary,
+			HTML:      fmt.Sprintf(`<p>%s</p><p><a href="%s">Read the full newsletter</a></p>`, template.HTMLEscapeString(summary), link),
+			Published: meta.Published,
+		})
+
+		if !cfg.FeedItems {
+			continue
+		}
+		for _, item := range meta.Items {
+			var html strings.Builder
+			if item.PosterURL != "" {
+				fmt.Fprintf(&html, `<p><img src="%s" alt="%s" width="150"></p>`, template.HTMLEscapeString(item.PosterURL), template.HTMLEscapeString(item.Title))
+			}
+			if item.Details != "" {
+				fmt.Fprintf(&html, "<p>%s</p>", strings.ReplaceAll(template.HTMLEscapeString(item.Details), "\n", "<br>"))
+			}
+			if item.Overview != "" {
+				fmt.Fprintf(&html, "<p>%s</p>", template.HTMLEscapeString(item.Overview))
+			}
+			summary := item.Overview
+			if summary == "" {
+				summary = strings.ReplaceAll(item.Details, "\n", ", ")
+			}
+			entries = append(entries, feedEntry{
+				ID:        link + "#" + item.Key,
+				Title:     item.Title,
+				Link:      link,
+				Summary:   truncateRunes(summary, 500),
+				HTML:      html.String(),
+				Published: meta.Published,
+				PosterURL: item.PosterURL,
+			})
+		}
+	}
+	return entries
+}
+
+// enclosureType guesses the poster MIME type from its URL, defaulting to JPEG
+func enclosureType(posterURL string) string {
+	if u, err := url.Parse(posterURL); err == nil {
+		if t := mime.TypeByExtension(strings.ToLower(path.Ext(u.Path))); strings.HasPrefix(t, "image/") {
+			return t
+		}
+	}
+	return "image/jpeg"
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href   string `xml:"href,attr"`
+	Rel    string `xml:"rel,attr,omitempty"`
+	Type   string `xml:"type,attr,omitempty"`
+	Length string `xml:"length,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	Title     string      `xml:"title"`
+	ID        string      `xml:"id"`
+	Updated   string      `xml:"updated"`
+	Published string      `xml:"published"`
+	Links     []atomLink  `xml:"link"`
+	Summary   string      `xml:"summary"`
+	Content   atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// atomHandler serves the Atom feed at /feed.xml
+func atomHandler(w http.ResponseWriter, r *http.Request) {
+	cfg := getConfig()
+	baseURL := feedBaseURL(cfg, r)
+	entries := buildFeedEntries(cfg, baseURL)
+
+	feed := atomFeed{
+		Title: feedTitle(cfg),
+		ID:    baseURL + "/feed.xml",
+		Links: []atomLink{
+			{Href: baseURL + "/feed.xml", Rel: "self", Type: "application/atom+xml"},
+			{Href: baseURL + "/archive/", Rel: "alternate", Type: "text/html"},
+		},
+		Author:  atomAuthor{Name: feedTitle(cfg)},
+		Updated: startTime.UTC().Format(time.RFC3339),
+	}
+	if len(entries) > 0 {
+		feed.Updated = entries[0].Published.UTC().Format(time.RFC3339)
+	}
+	for _, e := range entries {
+		entry := atomEntry{
+			Title:     e.Title,
+			ID:        e.ID,
+			Updated:   e.Published.UTC().Format(time.RFC3339),
+			Published: e.Published.UTC().Format(time.RFC3339),
+			Links:     []atomLink{{Href: e.Link, Rel: "alternate", Type: "text/html"}},
+			Summary:   e.Summary,
+			Content:   atomContent{Type: "html", Body: e.HTML},
+		}
+		if e.PosterURL != "" {
+			entry.Links = append(entry.Links, atomLink{Href: e.PosterURL, Rel: "enclosure", Type: enclosureType(e.PosterURL), Length: "0"})
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	writeFeed(w, "application/atom+xml; charset=utf-8", feed)
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	AtomNS  string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string      `xml:"title"`
+	Link          string      `xml:"link"`
+	Description   string      `xml:"description"`
+	AtomLink      rssAtomLink `xml:"atom:link"`
+	LastBuildDate string      `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem   `xml:"item"`
+}
+
+type rssAtomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link"`
+	Description string        `xml:"description"`
+	GUID        rssGUID       `xml:"guid"`
+	PubDate     string        `xml:"pubDate"`
+	Enclosure   *rssEnclosure `xml:"enclosure,omitempty"`
+}
+
+type rssGUID struct {
+	IsPermaLink string `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length string `xml:"length,attr"` // Unknown without downloading the poster, 0 is the accepted convention
+	Type   string `xml:"type,attr"`
+}
+
+// rssHandler serves the RSS 2.0 feed at /rss.xml
+func rssHandler(w http.ResponseWriter, r *http.Request) {
+	cfg := getConfig()
+	baseURL := feedBaseURL(cfg, r)
+	entries := buildFeedEntries(cfg, baseURL)
+
+	feed := rssFeed{
+		Version: "2.0",
+		AtomNS:  "http://www.w3.org/2005/Atom",
+		Channel: rssChannel{
+			Title:       feedTitle(cfg),
+			Link:        baseURL + "/archive/",
+			Description: "Newsletters about new and upcoming TV shows and movies",
+			AtomLink:    rssAtomLink{Href: baseURL + "/rss.xml", Rel: "self", Type: "application/rss+xml"},
+		},
+	}
+	if len(entries) > 0 {
+		feed.Channel.LastBuildDate = entries[0].Published.Format(time.RFC1123Z)
+	}
+	for _, e := range entries {
+		item := rssItem{
+			Title:       e.Title,
+			Link:        e.Link,
+			Description: e.HTML,
+			GUID:        rssGUID{IsPermaLink: "false", Value: e.ID},
+			PubDate:     e.Published.Format(time.RFC1123Z),
+		}
+		if e.PosterURL != "" {
+			item.Enclosure = &rssEnclosure{URL: e.PosterURL, Length: "0", Type: enclosureType(e.PosterURL)}
+		}
+		feed.Channel.Items = append(feed.Channel.Items, item)
+	}
+
+	writeFeed(w, "application/rss+xml; charset=utf-8", feed)
+}
+
+// writeFeed encodes a feed document with the XML declaration
+func writeFeed(w http.ResponseWriter, contentType string, feed interface{}) {
+	body, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write([]byte(xml.Header))
+	w.Write(body)
+}
@@ -3,18 +3,14 @@ package main
 import (
 	"compress/gzip"
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
-	"net/smtp"
 	"os"
 	"runtime"
-	"sort"
 	"strings"
-	"sync"
 	"time"
 )
 
@@ -28,14 +24,38 @@ func registerHandlers() {
 	http.HandleFunc("/api/config", configHandler)
 	http.HandleFunc("/api/test-sonarr", testSonarrHandler)
 	http.HandleFunc("/api/test-radarr", testRadarrHandler)
+	http.HandleFunc("/api/test-lidarr", testLidarrHandler)
+	http.HandleFunc("/api/test-readarr", testReadarrHandler)
 	http.HandleFunc("/api/test-trakt", testTraktHandler)
+	http.HandleFunc("/api/trakt/device-code", traktDeviceCodeHandler)
+	http.HandleFunc("/api/trakt/device-poll", traktDevicePollHandler)
+	http.HandleFunc("/api/trakt/disconnect", traktDisconnectHandler)
+	http.HandleFunc("/api/test-tmdb", testTMDBHandler)
+	http.HandleFunc("/api/test-jellyfin", testJellyfinHandler)
+	http.HandleFunc("/api/test-plex", testPlexHandler)
+	http.HandleFunc("/api/test-tautulli", testTautulliHandler)
+	http.HandleFunc("/api/test-bazarr", testBazarrHandler)
+	http.HandleFunc("/api/test-slack", testSlackHandler)
+	http.HandleFunc("/api/test-discord", testDiscordHandler)
+	http.HandleFunc("/api/test-telegram", testTelegramHandler)
+	http.HandleFunc("/api/test-matrix", testMatrixHandler)
+	http.HandleFunc("/api/test-ntfy", testNtfyHandler)
+	http.HandleFunc("/api/test-gotify", testGotifyHandler)
+	http.HandleFunc("/api/test-webhook", testWebhookHandler)
+	http.HandleFunc("/api/test-overseerr", testOverseerrHandler)
 	http.HandleFunc("/api/test-email", testEmailHandler)
 	http.HandleFunc("/api/send", sendHandler)
 	http.HandleFunc("/api/logs", logsHandler)
 	http.HandleFunc("/api/version", versionHandler)
 	http.HandleFunc("/api/preview", previewHandler)
+	http.HandleFunc("/api/admin-preview", adminPreviewHandler)
+	http.HandleFunc("/api/admin-send", adminSendHandler)
 	http.HandleFunc("/api/timezone-info", timezoneInfoHandler)
 	http.HandleFunc("/api/dashboard", dashboardHandler)
+	http.HandleFunc("/archive/", archiveHandler)
+	http.HandleFunc("/feed.xml", atomHandler)
+	http.HandleFunc("/rss.xml", rssHandler)
+	http.HandleFunc("/calendar.ics", calendarHandler)
 }
 
 // Gzip compression middleware
@@ -113,6 +133,39 @@ func detectInstallationType() string {
 	return "unknown"
 }
 
+// instanceStatuses returns the overall configuration status for a list of *arr instances
+// and a per-instance status map keyed by "service:name"
+func instanceStatuses(service string, instances []ArrInstance) (string, map[string]string) {
+	perInstance := make(map[string]string)
+	if len(instances) == 0 {
+		return "not_configured", perInstance
+	}
+
+	overall := "configured"
+	for _, inst := range instances {
+		key := service + ":" + inst.Name
+		if inst.configured() {
+			perInstance[key] = "configured"
+		} else {
+			perInstance[key] = "misconfigured"
+			overall = "misconfigured"
+		}
+	}
+	return overall, perInstance
+}
+
+// connectionStatus returns the configuration status of an optional URL + credential service
+// (Jellyfin/Emby, Plex, Tautulli, Bazarr, Overseerr): only one of the two being set is a misconfiguration
+func connectionStatus(url, key string) string {
+	if url != "" && key != "" {
+		return "configured"
+	}
+	if url != "" || key != "" {
+		return "misconfigured"
+	}
+	return "not_configured"
+}
+
 // Health check endpoint for monitoring and load balancers
 func healthHandler(w http.ResponseWriter, r *http.Request) {
 	cfg := getConfig()
@@ -121,28 +174,71 @@ func healthHandler(w http.ResponseWriter, r *http.Request) {
 	healthy := true
 	checks := make(map[string]string)
 
-	// Check Sonarr configuration
-	if cfg.SonarrURL != "" && cfg.SonarrAPIKey != "" {
-		checks["sonarr"] = "configured"
-	} else if cfg.SonarrURL == "" && cfg.SonarrAPIKey == "" {
-		checks["sonarr"] = "not_configured"
-	} else {
-		checks["sonarr"] = "misconfigured"
+	// Check Sonarr, Radarr and Readarr configuration (overall status plus one "service:name" entry per instance)
+	for service, instances := range map[string][]ArrInstance{"sonarr": cfg.SonarrInstances, "radarr": cfg.RadarrInstances, "readarr": cfg.ReadarrInstances} {
+		status, perInstance := instanceStatuses(service, instances)
+		checks[service] = status
+		for key, instanceStatus := range perInstance {
+			checks[key] = instanceStatus
+		}
+		if status == "misconfigured" {
+			healthy = false
+		}
+	}
+
+	// Check Lidarr configuration (optional)
+	checks["lidarr"] = connectionStatus(cfg.LidarrURL, cfg.LidarrAPIKey)
+	if checks["lidarr"] == "misconfigured" {
 		healthy = false
 	}
 
-	// Check Radarr configuration
-	if cfg.RadarrURL != "" && cfg.RadarrAPIKey != "" {
-		checks["radarr"] = "configured"
-	} else if cfg.RadarrURL == "" && cfg.RadarrAPIKey == "" {
-		checks["radarr"] = "not_configured"
-	} else {
-		checks["radarr"] = "misconfigured"
+	// Check Jellyfin/Emby and Plex configuration (optional)
+	checks["jellyfin"] = connectionStatus(cfg.JellyfinURL, cfg.JellyfinAPIKey)
+	checks["plex"] = connectionStatus(cfg.PlexURL, cfg.PlexToken)
+	if checks["jellyfin"] == "misconfigured" || checks["plex"] == "misconfigured" {
+		healthy = false
+	}
+
+	// Check Tautulli configuration (optional)
+	checks["tautulli"] = connectionStatus(cfg.TautulliURL, cfg.TautulliAPIKey)
+	if checks["tautulli"] == "misconfigured" {
+		healthy = false
+	}
+
+	// Check Bazarr configuration (optional)
+	checks["bazarr"] = connectionStatus(cfg.BazarrURL, cfg.BazarrAPIKey)
+	if checks["bazarr"] == "misconfigured" {
+		healthy = false
+	}
+
+	// Check Overseerr/Jellyseerr configuration (optional)
+	checks["overseerr"] = connectionStatus(cfg.OverseerrURL, cfg.OverseerrAPIKey)
+	if checks["overseerr"] == "misconfigured" {
 		healthy = false
 	}
 
+	// With ?deep=true, also query each *arr's own health checks ("health:name" entries).
+	// Only unreachable instances make the service unhealthy; health warnings are reported as-is.
+	if r.URL.Query().Get("deep") == "true" {
+		ctx, cancel := context.WithTimeout(r.Context(), time.Duration(cfg.APITimeout)*time.Second)
+		defer cancel()
+		for _, report := range buildAdminDigest(ctx, cfg).Instances {
+			key := "health:" + report.Name
+			switch {
+			case !report.Reachable:
+				checks[key] = "unreachable"
+				healthy = false
+			case report.Problems > 0:
+				checks[key] = fmt.Sprintf("%d issue(s)", report.Problems)
+			default:
+				checks[key] = "ok"
+			}
+		}
+	}
+
 	// Check if at least one service is configured
-	if checks["sonarr"] == "not_configured" && checks["radarr"] == "not_configured" {
+	if checks["sonarr"] == "not_configured" && checks["radarr"] == "not_configured" &&
+		checks["lidarr"] == "not_configured" && checks["readarr"] == "not_configured" {
 		checks["services"] = "none_configured"
 		healthy = false
 	} else {
@@ -150,8 +246,9 @@ func healthHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Check email configuration
-	// Email is configured if SMTP settings are present (recipients can be added later)
-	if cfg.SMTPHost != "" && cfg.SMTPPort != "" && cfg.SMTPUser != "" && cfg.SMTPPass != "" && cfg.FromEmail != "" {
+	// Email is configured if SMTP settings are present (recipients can be added later),
+	// credentials are optional for relays that accept mail without authentication
+	if cfg.SMTPHost != "" && cfg.SMTPPort != "" && cfg.FromEmail != "" && (cfg.SMTPUser == "" || cfg.SMTPPass != "") {
 		checks["email"] = "configured"
 	} else if cfg.SMTPHost == "" && cfg.SMTPPort == "" && cfg.FromEmail == "" {
 		checks["email"] = "not_configured"
@@ -211,250 +308,69 @@ func previewHandler(w http.ResponseWriter, r *http.Request) {
 	loc := getTimezone(cfg.Timezone)
 	now := time.Now().In(loc)
 
-	// Calculate timeframe based on schedule type
-	var weekStart, weekEnd time.Time
-	if cfg.ScheduleType == "monthly" {
-		// Monthly: previous month and current month
-		weekStart = time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, loc)
-		weekEnd = now
-	} else {
-		// Weekly: last 7 days
-		weekStart = now.AddDate(0, 0, -7)
-		weekEnd = now
-	}
-
 	// Parallel API calls with context
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.APITimeout)*time.Second)
 	defer cancel()
 
-	var wg sync.WaitGroup
-	var downloadedEpisodes, upcomingEpisodes []Episode
-	var downloadedMovies, upcomingMovies []Movie
-	var traktAnticipatedSeries, traktWatchedSeries []TraktShow
-	var traktAnticipatedMovies, traktWatchedMovies []TraktMovie
-
-	// Check which services are configured
-	hasSonarr := cfg.SonarrURL != "" && cfg.SonarrAPIKey != ""
-	hasRadarr := cfg.RadarrURL != "" && cfg.RadarrAPIKey != ""
-
-	// Count API calls (only for configured services + Trakt if enabled)
-	apiCalls := 0
-	if hasSonarr {
-		apiCalls += 2 // history + calendar
-	}
-	if hasRadarr {
-		apiCalls += 2 // history + calendar
-	}
-	if cfg.ShowTraktAnticipatedSeries {
-		apiCalls++
-	}
-	if cfg.ShowTraktWatchedSeries {
-		apiCalls++
-	}
-	if cfg.ShowTraktAnticipatedMovies {
-		apiCalls++
-	}
-	if cfg.ShowTraktWatchedMovies {
-		apiCalls++
-	}
-
-	wg.Add(apiCalls)
-
-	// Calculate upcoming period based on schedule type
-	var upcomingEnd time.Time
-	if cfg.ScheduleType == "monthly" {
-		upcomingEnd = weekEnd.AddDate(0, 1, 0) // Next month
-	} else {
-		upcomingEnd = weekEnd.AddDate(0, 0, 7) // Next 7 days
-	}
-
-	// Only fetch from Sonarr if configured
-	if hasSonarr {
-		go func() {
-			defer wg.Done()
-			downloadedEpisodes, _ = fetchSonarrHistoryWithRetry(ctx, cfg, weekStart, cfg.PreviewRetries)
-		}()
-
-		go func() {
-			defer wg.Done()
-			upcomingEpisodes, _ = fetchSonarrCalendarWithRetry(ctx, cfg, weekEnd, upcomingEnd, cfg.PreviewRetries)
-		}()
-	}
-
-	// Only fetch from Radarr if configured
-	if hasRadarr {
-		go func() {
-			defer wg.Done()
-			downloadedMovies, _ = fetchRadarrHistoryWithRetry(ctx, cfg, weekStart, cfg.PreviewRetries)
-		}()
-
-		go func() {
-			defer wg.Done()
-			upcomingMovies, _ = fetchRadarrCalendarWithRetry(ctx, cfg, weekEnd, upcomingEnd, cfg.PreviewRetries)
-		}()
-	}
-
-	// Fetch Trakt data if enabled
-	if cfg.ShowTraktAnticipatedSeries {
-		go func() {
-			defer wg.Done()
-			traktAnticipatedSeries, _ = fetchTraktAnticipatedSeries(ctx, cfg)
-		}()
-	}
-
-	if cfg.ShowTraktWatchedSeries {
-		go func() {
-			defer wg.Done()
-			traktWatchedSeries, _ = fetchTraktWatchedSeries(ctx, cfg)
-		}()
-	}
+	// Fetch errors are ignored so the preview still renders whatever data is available
+	data, _ := buildNewsletterData(ctx, cfg, calculateNewsletterPeriod(cfg, now), cfg.PreviewRetries)
 
-	if cfg.ShowTraktAnticipatedMovies {
-		go func() {
-			defer wg.Done()
-			traktAnticipatedMovies, _ = fetchTraktAnticipatedMovies(ctx, cfg)
-		}()
+	html, err := generateNewsletterHTML(data, cfg)
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Failed to generate preview: %v", err),
+		})
+		return
 	}
 
-	if cfg.ShowTraktWatchedMovies {
-		go func() {
-			defer wg.Done()
-			traktWatchedMovies, _ = fetchTraktWatchedMovies(ctx, cfg)
-		}()
-	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"html":    html,
+	})
+}
 
-	wg.Wait()
+func adminPreviewHandler(w http.ResponseWriter, r *http.Request) {
+	cfg := getConfig()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.APITimeout)*time.Second)
+	defer cancel()
 
-	// Filter unmonitored items from next week releases only (last week already downloaded)
-	if !cfg.ShowUnmonitored {
-		upcomingEpisodes = filterMonitoredEpisodes(upcomingEpisodes)
-		upcomingMovies = filterMonitoredMovies(upcomingMovies)
+	html, err := generateAdminDigestHTML(buildAdminDigest(ctx, cfg))
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Failed to generate admin digest: %v", err),
+		})
+		return
 	}
 
-	// Sort movies chronologically
-	sort.Slice(upcomingMovies, func(i, j int) bool {
-		return upcomingMovies[i].ReleaseDate < upcomingMovies[j].ReleaseDate
-	})
-	sort.Slice(downloadedMovies, func(i, j int) bool {
-		return downloadedMovies[i].ReleaseDate < downloadedMovies[j].ReleaseDate
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"html":    html,
 	})
+}
 
-	// Select appropriate strings based on schedule type
-	var emailTitle, weekRangePrefix, comingThisWeekHeading string
-	var noShowsMessage, noMoviesMessage string
-	var downloadedSectionHeading, noDownloadedShowsMessage, noDownloadedMoviesMessage string
-	var anticipatedSeriesHeading, watchedSeriesHeading string
-	var anticipatedMoviesHeading, watchedMoviesHeading string
-
-	if cfg.ScheduleType == "monthly" {
-		emailTitle = cfg.MonthlyEmailTitle
-		weekRangePrefix = cfg.MonthlyWeekRangePrefix
-		comingThisWeekHeading = cfg.MonthlyComingThisWeekHeading
-		noShowsMessage = cfg.MonthlyNoShowsMessage
-		noMoviesMessage = cfg.MonthlyNoMoviesMessage
-		downloadedSectionHeading = cfg.MonthlyDownloadedSectionHeading
-		noDownloadedShowsMessage = cfg.MonthlyNoDownloadedShowsMessage
-		noDownloadedMoviesMessage = cfg.MonthlyNoDownloadedMoviesMessage
-		anticipatedSeriesHeading = cfg.MonthlyAnticipatedSeriesHeading
-		watchedSeriesHeading = cfg.MonthlyWatchedSeriesHeading
-		anticipatedMoviesHeading = cfg.MonthlyAnticipatedMoviesHeading
-		watchedMoviesHeading = cfg.MonthlyWatchedMoviesHeading
-	} else {
-		emailTitle = cfg.EmailTitle
-		weekRangePrefix = cfg.WeekRangePrefix
-		comingThisWeekHeading = cfg.ComingThisWeekHeading
-		noShowsMessage = cfg.NoShowsMessage
-		noMoviesMessage = cfg.NoMoviesMessage
-		downloadedSectionHeading = cfg.DownloadedSectionHeading
-		noDownloadedShowsMessage = cfg.NoDownloadedShowsMessage
-		noDownloadedMoviesMessage = cfg.NoDownloadedMoviesMessage
-		anticipatedSeriesHeading = cfg.AnticipatedSeriesHeading
-		watchedSeriesHeading = cfg.WatchedSeriesHeading
-		anticipatedMoviesHeading = cfg.AnticipatedMoviesHeading
-		watchedMoviesHeading = cfg.WatchedMoviesHeading
-	}
-
-	// Format dates based on schedule type
-	var weekStartStr, weekEndStr, upcomingStartStr, upcomingEndStr string
-	if cfg.ScheduleType == "monthly" {
-		// For monthly, just show the current month name and year
-		currentMonth := weekEnd.Format("January 2006")
-		weekStartStr = currentMonth
-		weekEndStr = currentMonth
-		upcomingStartStr = currentMonth
-		upcomingEndStr = currentMonth
-	} else {
-		// For weekly, show full dates
-		weekStartStr = weekStart.Format("January 2, 2006")
-		weekEndStr = weekEnd.Format("January 2, 2006")
-		upcomingStartStr = weekEnd.Format("January 2, 2006")
-		upcomingEndStr = upcomingEnd.Format("January 2, 2006")
-	}
-
-	// Deduplicate episodes and movies
-	upcomingEpisodes = deduplicateEpisodes(upcomingEpisodes)
-	downloadedEpisodes = deduplicateEpisodes(downloadedEpisodes)
-	upcomingMovies = deduplicateMovies(upcomingMovies)
-	downloadedMovies = deduplicateMovies(downloadedMovies)
-
-	data := NewsletterData{
-		WeekStart:              weekStartStr,
-		WeekEnd:                weekEndStr,
-		UpcomingStart:          upcomingStartStr,
-		UpcomingEnd:            upcomingEndStr,
-		UpcomingSeriesGroups:   groupEpisodesBySeries(upcomingEpisodes),
-		UpcomingMovies:         upcomingMovies,
-		DownloadedSeriesGroups: groupEpisodesBySeries(downloadedEpisodes),
-		DownloadedMovies:       downloadedMovies,
-		TraktAnticipatedSeries: traktAnticipatedSeries,
-		TraktWatchedSeries:     traktWatchedSeries,
-		TraktAnticipatedMovies: traktAnticipatedMovies,
-		TraktWatchedMovies:     traktWatchedMovies,
-		// Customizable strings (schedule-aware)
-		EmailTitle:                emailTitle,
-		EmailIntro:                cfg.EmailIntro,
-		WeekRangePrefix:           weekRangePrefix,
-		ComingThisWeekHeading:     comingThisWeekHeading,
-		TVShowsHeading:            cfg.TVShowsHeading,
-		MoviesHeading:             cfg.MoviesHeading,
-		NoShowsMessage:            noShowsMessage,
-		NoMoviesMessage:           noMoviesMessage,
-		DownloadedSectionHeading:  downloadedSectionHeading,
-		NoDownloadedShowsMessage:  noDownloadedShowsMessage,
-		NoDownloadedMoviesMessage: noDownloadedMoviesMessage,
-		TrendingSectionHeading:    cfg.TrendingSectionHeading,
-		AnticipatedSeriesHeading:  anticipatedSeriesHeading,
-		WatchedSeriesHeading:      watchedSeriesHeading,
-		AnticipatedMoviesHeading:  anticipatedMoviesHeading,
-		WatchedMoviesHeading:      watchedMoviesHeading,
-		FooterText:                cfg.FooterText,
-		// Display options
-		ShowPosters:                cfg.ShowPosters,
-		ShowDownloaded:             cfg.ShowDownloaded,
-		ShowSeriesOverview:         cfg.ShowSeriesOverview,
-		ShowEpisodeOverview:        cfg.ShowEpisodeOverview,
-		ShowSeriesRatings:          cfg.ShowSeriesRatings,
-		DarkMode:                   cfg.DarkMode,
-		ShowTraktAnticipatedSeries: cfg.ShowTraktAnticipatedSeries,
-		ShowTraktWatchedSeries:     cfg.ShowTraktWatchedSeries,
-		ShowTraktAnticipatedMovies: cfg.ShowTraktAnticipatedMovies,
-		ShowTraktWatchedMovies:     cfg.ShowTraktWatchedMovies,
-	}
-
-	html, err := generateNewsletterHTML(data, cfg)
-	if err != nil {
+func adminSendHandler(w http.ResponseWriter, r *http.Request) {
+	if len(getConfig().AdminEmails) == 0 {
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(map[string]interface{}{
 			"success": false,
-			"error":   fmt.Sprintf("Failed to generate preview: %v", err),
+			"message": "No admin recipients configured",
 		})
 		return
 	}
 
+	// Send immediately
+	go runAdminDigest()
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"success": true,
-		"html":    html,
+		"message": "Admin digest generation started",
 	})
 }
 
@@ -476,35 +392,116 @@ func configHandler(w http.ResponseWriter, r *http.Request) {
 		// This prevents template settings from clearing API keys
 		hasMainConfigFields := webCfg.SonarrURL != "" || webCfg.SonarrAPIKey != "" ||
 			webCfg.RadarrURL != "" || webCfg.RadarrAPIKey != "" ||
-			webCfg.TraktClientID != "" || webCfg.SMTPHost != "" ||
+			webCfg.TraktClientID != "" || webCfg.TraktClientSecret != "" || webCfg.SMTPHost != "" ||
+			webCfg.TMDBAPIKey != "" ||
+			webCfg.JellyfinURL != "" || webCfg.JellyfinAPIKey != "" ||
+			webCfg.PlexURL != "" || webCfg.PlexToken != "" ||
+			webCfg.OverseerrURL != "" || webCfg.OverseerrAPIKey != "" ||
+			webCfg.TautulliURL != "" || webCfg.TautulliAPIKey != "" ||
+			webCfg.BazarrURL != "" || webCfg.BazarrAPIKey != "" ||
+			webCfg.LidarrURL != "" || webCfg.LidarrAPIKey != "" ||
+			webCfg.ReadarrURL != "" || webCfg.ReadarrAPIKey != "" ||
 			webCfg.SMTPPort != "" || webCfg.SMTPUser != "" ||
 			webCfg.SMTPPass != "" || webCfg.FromEmail != "" ||
 			webCfg.FromName != "" || webCfg.ToEmails != "" ||
+			webCfg.SlackWebhookURL != "" || webCfg.DiscordWebhookURL != "" || webCfg.TelegramBotToken != "" ||
+			webCfg.TelegramChatIDs != "" || webCfg.MatrixHomeserverURL != "" ||
+			webCfg.NtfyTopic != "" || webCfg.GotifyURL != "" || webCfg.WebhookURL != "" || webCfg.PublicURL != "" ||
 			webCfg.Timezone != "" || webCfg.ScheduleDay != "" ||
-			webCfg.ScheduleTime != "" ||
+			webCfg.ScheduleTime != "" || webCfg.AdminEmails != "" ||
+			len(webCfg.SonarrInstances) > 0 || len(webCfg.RadarrInstances) > 0 ||
+			len(webCfg.ReadarrInstances) > 0 || len(webCfg.TraktLists) > 0 ||
 			webCfg.SonarrAPIKey == maskedPlaceholder ||
 			webCfg.RadarrAPIKey == maskedPlaceholder ||
 			webCfg.TraktClientID == maskedPlaceholder ||
-			webCfg.SMTPPass == maskedPlaceholder
+			webCfg.TraktClientSecret == maskedPlaceholder ||
+			webCfg.TMDBAPIKey == maskedPlaceholder ||
+			webCfg.JellyfinAPIKey == maskedPlaceholder ||
+			webCfg.PlexToken == maskedPlaceholder ||
+			webCfg.OverseerrAPIKey == maskedPlaceholder ||
+			webCfg.TautulliAPIKey == maskedPlaceholder ||
+			webCfg.BazarrAPIKey == maskedPlaceholder ||
+			webCfg.LidarrAPIKey == maskedPlaceholder ||
+			webCfg.ReadarrAPIKey == maskedPlaceholder ||
+			webCfg.SMTPPass == maskedPlaceholder ||
+			webCfg.SlackWebhookURL == maskedPlaceholder ||
+			webCfg.DiscordWebhookURL == maskedPlaceholder ||
+			webCfg.TelegramBotToken == maskedPlaceholder ||
+			webCfg.MatrixAccessToken == maskedPlaceholder ||
+			webCfg.NtfyToken == maskedPlaceholder ||
+			webCfg.GotifyToken == maskedPlaceholder ||
+			webCfg.WebhookSecret == maskedPlaceholder
 
 		// Only update main config fields if they're being submitted
 		if hasMainConfigFields {
+			cfg := getConfig()
+			// Empty instance names fall back to the defaults ("Sonarr", "Radarr", "Readarr")
+			envMap["SONARR_NAME"] = webCfg.SonarrName
 			// Allow clearing URLs - update even if empty (same as API keys)
 			envMap["SONARR_URL"] = webCfg.SonarrURL
 			// Allow clearing API keys - update if not masked (even if empty)
 			if webCfg.SonarrAPIKey != maskedPlaceholder {
 				envMap["SONARR_API_KEY"] = webCfg.SonarrAPIKey
 			}
+			saveArrInstances(envMap, "SONARR", webCfg.SonarrInstances, cfg.SonarrInstances)
+			envMap["RADARR_NAME"] = webCfg.RadarrName
 			// Allow clearing URLs - update even if empty (same as API keys)
 			envMap["RADARR_URL"] = webCfg.RadarrURL
 			// Allow clearing API keys - update if not masked (even if empty)
 			if webCfg.RadarrAPIKey != maskedPlaceholder {
 				envMap["RADARR_API_KEY"] = webCfg.RadarrAPIKey
 			}
+			saveArrInstances(envMap, "RADARR", webCfg.RadarrInstances, cfg.RadarrInstances)
+			envMap["READARR_NAME"] = webCfg.ReadarrName
+			envMap["READARR_URL"] = webCfg.ReadarrURL
+			if webCfg.ReadarrAPIKey != maskedPlaceholder {
+				envMap["READARR_API_KEY"] = webCfg.ReadarrAPIKey
+			}
+			saveArrInstances(envMap, "READARR", webCfg.ReadarrInstances, cfg.ReadarrInstances)
+			// Allow clearing Lidarr - same rules as Sonarr/Radarr
+			envMap["LIDARR_URL"] = webCfg.LidarrURL
+			if webCfg.LidarrAPIKey != maskedPlaceholder {
+				envMap["LIDARR_API_KEY"] = webCfg.LidarrAPIKey
+			}
 			// Allow clearing Trakt Client ID - update if not masked (even if empty)
 			if webCfg.TraktClientID != maskedPlaceholder {
 				envMap["TRAKT_CLIENT_ID"] = webCfg.TraktClientID
 			}
+			if webCfg.TraktClientSecret != maskedPlaceholder {
+				envMap["TRAKT_CLIENT_SECRET"] = webCfg.TraktClientSecret
+			}
+			if webCfg.TMDBAPIKey != maskedPlaceholder {
+				envMap["TMDB_API_KEY"] = webCfg.TMDBAPIKey
+			}
+			saveTraktLists(envMap, webCfg.TraktLists)
+			// Allow clearing the Jellyfin/Emby server - same rules as Sonarr/Radarr
+			envMap["JELLYFIN_URL"] = webCfg.JellyfinURL
+			if webCfg.JellyfinAPIKey != maskedPlaceholder {
+				envMap["JELLYFIN_API_KEY"] = webCfg.JellyfinAPIKey
+			}
+			if webCfg.JellyfinServerType != "" {
+				envMap["JELLYFIN_SERVER_TYPE"] = webCfg.JellyfinServerType
+			}
+			// Allow clearing the Plex server - same rules as Sonarr/Radarr
+			envMap["PLEX_URL"] = webCfg.PlexURL
+			if webCfg.PlexToken != maskedPlaceholder {
+				envMap["PLEX_TOKEN"] = webCfg.PlexToken
+			}
+			// Allow clearing Tautulli - same rules as Sonarr/Radarr
+			envMap["TAUTULLI_URL"] = webCfg.TautulliURL
+			if webCfg.TautulliAPIKey != maskedPlaceholder {
+				envMap["TAUTULLI_API_KEY"] = webCfg.TautulliAPIKey
+			}
+			// Allow clearing Bazarr - same rules as Sonarr/Radarr
+			envMap["BAZARR_URL"] = webCfg.BazarrURL
+			if webCfg.BazarrAPIKey != maskedPlaceholder {
+				envMap["BAZARR_API_KEY"] = webCfg.BazarrAPIKey
+			}
+			// Allow clearing Overseerr/Jellyseerr - same rules as Sonarr/Radarr
+			envMap["OVERSEERR_URL"] = webCfg.OverseerrURL
+			if webCfg.OverseerrAPIKey != maskedPlaceholder {
+				envMap["OVERSEERR_API_KEY"] = webCfg.OverseerrAPIKey
+			}
 			if webCfg.SMTPHost != "" {
 				envMap["SMTP_HOST"] = webCfg.SMTPHost
 			}
@@ -518,6 +515,14 @@ func configHandler(w http.ResponseWriter, r *http.Request) {
 			if webCfg.SMTPPass != maskedPlaceholder {
 				envMap["SMTP_PASS"] = webCfg.SMTPPass
 			}
+			if webCfg.SMTPSecurity != "" {
+				envMap["SMTP_SECURITY"] = webCfg.SMTPSecurity
+			}
+			// Allow clearing the CA file
+			envMap["SMTP_CA_FILE"] = webCfg.SMTPCAFile
+			if webCfg.SMTPTLSSkipVerify != "" {
+				envMap["SMTP_TLS_SKIP_VERIFY"] = webCfg.SMTPTLSSkipVerify
+			}
 			if webCfg.FromEmail != "" {
 				envMap["FROM_EMAIL"] = webCfg.FromEmail
 			}
@@ -526,6 +531,42 @@ func configHandler(w http.ResponseWriter, r *http.Request) {
 			}
 			// Always update TO_EMAILS, even if empty (allows clearing all recipients)
 			envMap["TO_EMAILS"] = webCfg.ToEmails
+			// Webhook URLs contain their token - masked like an API key, cleared when empty
+			if webCfg.SlackWebhookURL != maskedPlaceholder {
+				envMap["SLACK_WEBHOOK_URL"] = webCfg.SlackWebhookURL
+			}
+			if webCfg.DiscordWebhookURL != maskedPlaceholder {
+				envMap["DISCORD_WEBHOOK_URL"] = webCfg.DiscordWebhookURL
+			}
+			if webCfg.TelegramBotToken != maskedPlaceholder {
+				envMap["TELEGRAM_BOT_TOKEN"] = webCfg.TelegramBotToken
+			}
+			envMap["TELEGRAM_CHAT_IDS"] = webCfg.TelegramChatIDs
+			if webCfg.TelegramAPIURL != "" {
+				envMap["TELEGRAM_API_URL"] = webCfg.TelegramAPIURL
+			}
+			// Allow clearing the push services - same rules as Sonarr/Radarr
+			envMap["MATRIX_HOMESERVER_URL"] = webCfg.MatrixHomeserverURL
+			if webCfg.MatrixAccessToken != maskedPlaceholder {
+				envMap["MATRIX_ACCESS_TOKEN"] = webCfg.MatrixAccessToken
+			}
+			envMap["MATRIX_ROOM_ID"] = webCfg.MatrixRoomID
+			if webCfg.NtfyURL != "" {
+				envMap["NTFY_URL"] = webCfg.NtfyURL
+			}
+			envMap["NTFY_TOPIC"] = webCfg.NtfyTopic
+			if webCfg.NtfyToken != maskedPlaceholder {
+				envMap["NTFY_TOKEN"] = webCfg.NtfyToken
+			}
+			envMap["GOTIFY_URL"] = webCfg.GotifyURL
+			if webCfg.GotifyToken != maskedPlaceholder {
+				envMap["GOTIFY_TOKEN"] = webCfg.GotifyToken
+			}
+			envMap["WEBHOOK_URL"] = webCfg.WebhookURL
+			if webCfg.WebhookSecret != maskedPlaceholder {
+				envMap["WEBHOOK_SECRET"] = webCfg.WebhookSecret
+			}
+			envMap["PUBLIC_URL"] = webCfg.PublicURL
 			if webCfg.Timezone != "" {
 				envMap["TIMEZONE"] = webCfg.Timezone
 			}
@@ -541,6 +582,17 @@ func configHandler(w http.ResponseWriter, r *http.Request) {
 			if webCfg.ScheduleDayOfMonth != "" {
 				envMap["SCHEDULE_DAY_OF_MONTH"] = webCfg.ScheduleDayOfMonth
 			}
+			// Always update ADMIN_EMAILS, even if empty (clearing it disables the admin digest)
+			envMap["ADMIN_EMAILS"] = webCfg.AdminEmails
+			if webCfg.AdminScheduleType != "" {
+				envMap["ADMIN_SCHEDULE_TYPE"] = webCfg.AdminScheduleType
+			}
+			if webCfg.AdminScheduleDay != "" {
+				envMap["ADMIN_SCHEDULE_DAY"] = webCfg.AdminScheduleDay
+			}
+			if webCfg.AdminScheduleTime != "" {
+				envMap["ADMIN_SCHEDULE_TIME"] = webCfg.AdminScheduleTime
+			}
 		}
 		if webCfg.ShowPosters != "" {
 			envMap["SHOW_POSTERS"] = webCfg.ShowPosters
@@ -587,6 +639,57 @@ func configHandler(w http.ResponseWriter, r *http.Request) {
 		if webCfg.TraktWatchedMoviesLimit != "" {
 			envMap["TRAKT_WATCHED_MOVIES_LIMIT"] = webCfg.TraktWatchedMoviesLimit
 		}
+		if webCfg.ShowServerMostWatched != "" {
+			envMap["SHOW_SERVER_MOST_WATCHED"] = webCfg.ShowServerMostWatched
+		}
+		if webCfg.ShowServerRecentlyAdded != "" {
+			envMap["SHOW_SERVER_RECENTLY_ADDED"] = webCfg.ShowServerRecentlyAdded
+		}
+		if webCfg.ServerMostWatchedLimit != "" {
+			envMap["SERVER_MOST_WATCHED_LIMIT"] = webCfg.ServerMostWatchedLimit
+		}
+		if webCfg.ServerRecentlyAddedLimit != "" {
+			envMap["SERVER_RECENTLY_ADDED_LIMIT"] = webCfg.ServerRecentlyAddedLimit
+		}
+		if webCfg.ShowMusic != "" {
+			envMap["SHOW_MUSIC"] = webCfg.ShowMusic
+		}
+		if webCfg.ShowBooks != "" {
+			envMap["SHOW_BOOKS"] = webCfg.ShowBooks
+		}
+		if webCfg.ShowPendingRequests != "" {
+			envMap["SHOW_PENDING_REQUESTS"] = webCfg.ShowPendingRequests
+		}
+		if webCfg.ShowServerStats != "" {
+			envMap["SHOW_SERVER_STATS"] = webCfg.ShowServerStats
+		}
+		if webCfg.ShowSubtitles != "" {
+			envMap["SHOW_SUBTITLES"] = webCfg.ShowSubtitles
+		}
+		if webCfg.ShowQueue != "" {
+			envMap["SHOW_QUEUE"] = webCfg.ShowQueue
+		}
+		if webCfg.ShowMissing != "" {
+			envMap["SHOW_MISSING"] = webCfg.ShowMissing
+		}
+		if webCfg.FeedItems != "" {
+			envMap["FEED_ITEMS"] = webCfg.FeedItems
+		}
+		if webCfg.EmailAttachICS != "" {
+			envMap["EMAIL_ATTACH_ICS"] = webCfg.EmailAttachICS
+		}
+		if webCfg.EmailInlinePosters != "" {
+			envMap["EMAIL_INLINE_POSTERS"] = webCfg.EmailInlinePosters
+		}
+		if webCfg.ShowTraktWatchlist != "" {
+			envMap["SHOW_TRAKT_WATCHLIST"] = webCfg.ShowTraktWatchlist
+		}
+		if webCfg.ShowTraktRecommendations != "" {
+			envMap["SHOW_TRAKT_RECOMMENDATIONS"] = webCfg.ShowTraktRecommendations
+		}
+		if webCfg.ShowTraktCalendar != "" {
+			envMap["SHOW_TRAKT_CALENDAR"] = webCfg.ShowTraktCalendar
+		}
 		// Email string customization
 		// Only update if at least one custom string field is provided
 		// This prevents wiping them when saving from other tabs
@@ -599,7 +702,12 @@ func configHandler(w http.ResponseWriter, r *http.Request) {
 			webCfg.NoDownloadedMoviesMessage != "" || webCfg.TrendingSectionHeading != "" ||
 			webCfg.AnticipatedSeriesHeading != "" || webCfg.WatchedSeriesHeading != "" ||
 			webCfg.AnticipatedMoviesHeading != "" || webCfg.WatchedMoviesHeading != "" ||
-			webCfg.FooterText != ""
+			webCfg.MostWatchedHeading != "" || webCfg.RecentlyAddedHeading != "" ||
+			webCfg.MusicHeading != "" || webCfg.BooksHeading != "" ||
+			webCfg.PendingRequestsHeading != "" || webCfg.ServerStatsHeading != "" ||
+			webCfg.QueueHeading != "" || webCfg.MissingHeading != "" ||
+			webCfg.TraktWatchlistHeading != "" || webCfg.RecommendationsHeading != "" ||
+			webCfg.TraktCalendarHeading != "" || webCfg.FooterText != ""
 
 		// Only update custom strings if they're being submitted (from template tab)
 		// Allow empty strings for intentional clearing when submitting from template tab
@@ -620,6 +728,17 @@ func configHandler(w http.ResponseWriter, r *http.Request) {
 			envMap["WATCHED_SERIES_HEADING"] = webCfg.WatchedSeriesHeading
 			envMap["ANTICIPATED_MOVIES_HEADING"] = webCfg.AnticipatedMoviesHeading
 			envMap["WATCHED_MOVIES_HEADING"] = webCfg.WatchedMoviesHeading
+			envMap["MOST_WATCHED_HEADING"] = webCfg.MostWatchedHeading
+			envMap["RECENTLY_ADDED_HEADING"] = webCfg.RecentlyAddedHeading
+			envMap["MUSIC_HEADING"] = webCfg.MusicHeading
+			envMap["BOOKS_HEADING"] = webCfg.BooksHeading
+			envMap["PENDING_REQUESTS_HEADING"] = webCfg.PendingRequestsHeading
+			envMap["SERVER_STATS_HEADING"] = webCfg.ServerStatsHeading
+			envMap["QUEUE_HEADING"] = webCfg.QueueHeading
+			envMap["MISSING_HEADING"] = webCfg.MissingHeading
+			envMap["TRAKT_WATCHLIST_HEADING"] = webCfg.TraktWatchlistHeading
+			envMap["RECOMMENDATIONS_HEADING"] = webCfg.RecommendationsHeading
+			envMap["TRAKT_CALENDAR_HEADING"] = webCfg.TraktCalendarHeading
 			envMap["FOOTER_TEXT"] = webCfg.FooterText
 			// Monthly versions
 			envMap["MONTHLY_EMAIL_TITLE"] = webCfg.MonthlyEmailTitle
@@ -679,29 +798,161 @@ func configHandler(w http.ResponseWriter, r *http.Request) {
 	if key := getEnvFromFileOnly(envMap, "TRAKT_CLIENT_ID", ""); key != "" {
 		maskedTraktKey = "••••••••"
 	}
+	maskedTraktSecret := ""
+	if key := getEnvFromFileOnly(envMap, "TRAKT_CLIENT_SECRET", ""); key != "" {
+		maskedTraktSecret = "••••••••"
+	}
+	maskedTMDBKey := ""
+	if key := getEnvFromFileOnly(envMap, "TMDB_API_KEY", ""); key != "" {
+		maskedTMDBKey = "••••••••"
+	}
+	traktUsername := ""
+	traktTokenMu.Lock()
+	token := loadTraktToken()
+	traktTokenMu.Unlock()
+	if token != nil {
+		traktUsername = token.Username
+	}
+	maskedReadarrKey := ""
+	if key := getEnvFromFileOnly(envMap, "READARR_API_KEY", ""); key != "" {
+		maskedReadarrKey = "••••••••"
+	}
+	maskedJellyfinKey := ""
+	if key := getEnvFromFileOnly(envMap, "JELLYFIN_API_KEY", ""); key != "" {
+		maskedJellyfinKey = "••••••••"
+	}
+	maskedLidarrKey := ""
+	if key := getEnvFromFileOnly(envMap, "LIDARR_API_KEY", ""); key != "" {
+		maskedLidarrKey = "••••••••"
+	}
+	maskedPlexToken := ""
+	if key := getEnvFromFileOnly(envMap, "PLEX_TOKEN", ""); key != "" {
+		maskedPlexToken = "••••••••"
+	}
+	maskedSlackWebhook := ""
+	if webhook := getEnvFromFileOnly(envMap, "SLACK_WEBHOOK_URL", ""); webhook != "" {
+		maskedSlackWebhook = "••••••••"
+	}
+	maskedDiscordWebhook := ""
+	if webhook := getEnvFromFileOnly(envMap, "DISCORD_WEBHOOK_URL", ""); webhook != "" {
+		maskedDiscordWebhook = "••••••••"
+	}
+	maskedTelegramToken := ""
+	if key := getEnvFromFileOnly(envMap, "TELEGRAM_BOT_TOKEN", ""); key != "" {
+		maskedTelegramToken = "••••••••"
+	}
+	maskedMatrixToken := ""
+	if key := getEnvFromFileOnly(envMap, "MATRIX_ACCESS_TOKEN", ""); key != "" {
+		maskedMatrixToken = "••••••••"
+	}
+	maskedNtfyToken := ""
+	if key := getEnvFromFileOnly(envMap, "NTFY_TOKEN", ""); key != "" {
+		maskedNtfyToken = "••••••••"
+	}
+	maskedGotifyToken := ""
+	if key := getEnvFromFileOnly(envMap, "GOTIFY_TOKEN", ""); key != "" {
+		maskedGotifyToken = "••••••••"
+	}
+	maskedWebhookSecret := ""
+	if key := getEnvFromFileOnly(envMap, "WEBHOOK_SECRET", ""); key != "" {
+		maskedWebhookSecret = "••••••••"
+	}
+	maskedBazarrKey := ""
+	if key := getEnvFromFileOnly(envMap, "BAZARR_API_KEY", ""); key != "" {
+		maskedBazarrKey = "••••••••"
+	}
+	maskedTautulliKey := ""
+	if key := getEnvFromFileOnly(envMap, "TAUTULLI_API_KEY", ""); key != "" {
+		maskedTautulliKey = "••••••••"
+	}
+	maskedOverseerrKey := ""
+	if key := getEnvFromFileOnly(envMap, "OVERSEERR_API_KEY", ""); key != "" {
+		maskedOverseerrKey = "••••••••"
+	}
 	maskedSMTPPass := ""
 	if cfg.SMTPPass != "" {
 		maskedSMTPPass = "••••••••"
 	}
 
-	json.NewEncoder(w).Encode(map[string]string{
+	// Additional instances are returned with masked API keys as well
+	sonarrInstances := loadAdditionalArrInstances(envMap, "SONARR", DefaultSonarrName)
+	radarrInstances := loadAdditionalArrInstances(envMap, "RADARR", DefaultRadarrName)
+	readarrInstances := loadAdditionalArrInstances(envMap, "READARR", DefaultReadarrName)
+	for _, instances := range [][]ArrInstance{sonarrInstances, radarrInstances, readarrInstances} {
+		for i := range instances {
+			if instances[i].APIKey != "" {
+				instances[i].APIKey = "••••••••"
+			}
+		}
+	}
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"sonarr_name":                    getEnvFromFileOnly(envMap, "SONARR_NAME", DefaultSonarrName),
 		"sonarr_url":                     getEnvFromFileOnly(envMap, "SONARR_URL", ""),
 		"sonarr_api_key":                 maskedSonarrKey,
+		"sonarr_instances":               sonarrInstances,
+		"radarr_name":                    getEnvFromFileOnly(envMap, "RADARR_NAME", DefaultRadarrName),
 		"radarr_url":                     getEnvFromFileOnly(envMap, "RADARR_URL", ""),
 		"radarr_api_key":                 maskedRadarrKey,
+		"radarr_instances":               radarrInstances,
+		"readarr_name":                   getEnvFromFileOnly(envMap, "READARR_NAME", DefaultReadarrName),
+		"readarr_url":                    getEnvFromFileOnly(envMap, "READARR_URL", ""),
+		"readarr_api_key":                maskedReadarrKey,
+		"readarr_instances":              readarrInstances,
+		"lidarr_url":                     getEnvFromFileOnly(envMap, "LIDARR_URL", ""),
+		"lidarr_api_key":                 maskedLidarrKey,
 		"trakt_client_id":                maskedTraktKey,
+		"trakt_client_secret":            maskedTraktSecret,
+		"tmdb_api_key":                   maskedTMDBKey,
+		"trakt_lists":                    loadTraktLists(envMap),
+		"trakt_connected":                token != nil,
+		"trakt_username":                 traktUsername,
+		"jellyfin_url":                   getEnvFromFileOnly(envMap, "JELLYFIN_URL", ""),
+		"jellyfin_api_key":               maskedJellyfinKey,
+		"jellyfin_server_type":           getEnvFromFile(envMap, "JELLYFIN_SERVER_TYPE", DefaultJellyfinServerType),
+		"plex_url":                       getEnvFromFileOnly(envMap, "PLEX_URL", ""),
+		"plex_token":                     maskedPlexToken,
+		"tautulli_url":                   getEnvFromFileOnly(envMap, "TAUTULLI_URL", ""),
+		"tautulli_api_key":               maskedTautulliKey,
+		"bazarr_url":                     getEnvFromFileOnly(envMap, "BAZARR_URL", ""),
+		"bazarr_api_key":                 maskedBazarrKey,
+		"overseerr_url":                  getEnvFromFileOnly(envMap, "OVERSEERR_URL", ""),
+		"overseerr_api_key":              maskedOverseerrKey,
 		"smtp_host":                      cfg.SMTPHost,
 		"smtp_port":                      cfg.SMTPPort,
 		"smtp_user":                      cfg.SMTPUser,
 		"smtp_pass":                      maskedSMTPPass,
+		"smtp_security":                  cfg.SMTPSecurity,
+		"smtp_ca_file":                   cfg.SMTPCAFile,
+		"smtp_tls_skip_verify":           getEnvFromFile(envMap, "SMTP_TLS_SKIP_VERIFY", DefaultSMTPTLSSkipVerify),
 		"from_email":                     getEnvFromFile(envMap, "FROM_EMAIL", ""),
 		"from_name":                      getEnvFromFile(envMap, "FROM_NAME", DefaultFromName),
 		"to_emails":                      getEnvFromFile(envMap, "TO_EMAILS", ""),
+		"slack_webhook_url":              maskedSlackWebhook,
+		"discord_webhook_url":            maskedDiscordWebhook,
+		"telegram_bot_token":             maskedTelegramToken,
+		"telegram_chat_ids":              getEnvFromFileOnly(envMap, "TELEGRAM_CHAT_IDS", ""),
+		"telegram_api_url":               getEnvFromFile(envMap, "TELEGRAM_API_URL", DefaultTelegramAPIURL),
+		"matrix_homeserver_url":          getEnvFromFileOnly(envMap, "MATRIX_HOMESERVER_URL", ""),
+		"matrix_access_token":            maskedMatrixToken,
+		"matrix_room_id":                 getEnvFromFileOnly(envMap, "MATRIX_ROOM_ID", ""),
+		"ntfy_url":                       getEnvFromFile(envMap, "NTFY_URL", DefaultNtfyURL),
+		"ntfy_topic":                     getEnvFromFileOnly(envMap, "NTFY_TOPIC", ""),
+		"ntfy_token":                     maskedNtfyToken,
+		"gotify_url":                     getEnvFromFileOnly(envMap, "GOTIFY_URL", ""),
+		"gotify_token":                   maskedGotifyToken,
+		"webhook_url":                    getEnvFromFileOnly(envMap, "WEBHOOK_URL", ""),
+		"webhook_secret":                 maskedWebhookSecret,
+		"public_url":                     getEnvFromFile(envMap, "PUBLIC_URL", ""),
 		"timezone":                       getEnvFromFile(envMap, "TIMEZONE", DefaultTimezone),
 		"schedule_day":                   getEnvFromFile(envMap, "SCHEDULE_DAY", DefaultScheduleDay),
 		"schedule_time":                  getEnvFromFile(envMap, "SCHEDULE_TIME", DefaultScheduleTime),
 		"schedule_type":                  getEnvFromFile(envMap, "SCHEDULE_TYPE", DefaultScheduleType),
 		"schedule_day_of_month":          fmt.Sprintf("%d", cfg.ScheduleDayOfMonth),
+		"admin_emails":                   getEnvFromFile(envMap, "ADMIN_EMAILS", ""),
+		"admin_schedule_type":            getEnvFromFile(envMap, "ADMIN_SCHEDULE_TYPE", DefaultAdminScheduleType),
+		"admin_schedule_day":             getEnvFromFile(envMap, "ADMIN_SCHEDULE_DAY", DefaultAdminScheduleDay),
+		"admin_schedule_time":            getEnvFromFile(envMap, "ADMIN_SCHEDULE_TIME", DefaultAdminScheduleTime),
 		"show_posters":                   getEnvFromFile(envMap, "SHOW_POSTERS", DefaultShowPosters),
 		"show_downloaded":                getEnvFromFile(envMap, "SHOW_DOWNLOADED", DefaultShowDownloaded),
 		"show_series_overview":           getEnvFromFile(envMap, "SHOW_SERIES_OVERVIEW", DefaultShowSeriesOverview),
@@ -717,6 +968,23 @@ func configHandler(w http.ResponseWriter, r *http.Request) {
 		"trakt_watched_series_limit":     getEnvFromFile(envMap, "TRAKT_WATCHED_SERIES_LIMIT", "5"),
 		"trakt_anticipated_movies_limit": getEnvFromFile(envMap, "TRAKT_ANTICIPATED_MOVIES_LIMIT", "5"),
 		"trakt_watched_movies_limit":     getEnvFromFile(envMap, "TRAKT_WATCHED_MOVIES_LIMIT", "5"),
+		"show_server_most_watched":       getEnvFromFile(envMap, "SHOW_SERVER_MOST_WATCHED", DefaultShowServerMostWatched),
+		"show_server_recently_added":     getEnvFromFile(envMap, "SHOW_SERVER_RECENTLY_ADDED", DefaultShowServerRecentlyAdded),
+		"server_most_watched_limit":      fmt.Sprintf("%d", cfg.ServerMostWatchedLimit),
+		"server_recently_added_limit":    fmt.Sprintf("%d", cfg.ServerRecentlyAddedLimit),
+		"show_music":                     getEnvFromFile(envMap, "SHOW_MUSIC", DefaultShowMusic),
+		"show_books":                     getEnvFromFile(envMap, "SHOW_BOOKS", DefaultShowBooks),
+		"show_pending_requests":          getEnvFromFile(envMap, "SHOW_PENDING_REQUESTS", DefaultShowPendingRequests),
+		"show_server_stats":              getEnvFromFile(envMap, "SHOW_SERVER_STATS", DefaultShowServerStats),
+		"show_subtitles":                 getEnvFromFile(envMap, "SHOW_SUBTITLES", DefaultShowSubtitles),
+		"show_queue":                     getEnvFromFile(envMap, "SHOW_QUEUE", DefaultShowQueue),
+		"show_missing":                   getEnvFromFile(envMap, "SHOW_MISSING", DefaultShowMissing),
+		"feed_items":                     getEnvFromFile(envMap, "FEED_ITEMS", DefaultFeedItems),
+		"email_attach_ics":               getEnvFromFile(envMap, "EMAIL_ATTACH_ICS", DefaultEmailAttachICS),
+		"email_inline_posters":           getEnvFromFile(envMap, "EMAIL_INLINE_POSTERS", DefaultEmailInlinePosters),
+		"show_trakt_watchlist":           getEnvFromFile(envMap, "SHOW_TRAKT_WATCHLIST", DefaultShowTraktWatchlist),
+		"show_trakt_recommendations":     getEnvFromFile(envMap, "SHOW_TRAKT_RECOMMENDATIONS", DefaultShowTraktRecommendations),
+		"show_trakt_calendar":            getEnvFromFile(envMap, "SHOW_TRAKT_CALENDAR", DefaultShowTraktCalendar),
 		// Email string customization
 		"email_title":                  getEnvFromFile(envMap, "EMAIL_TITLE", DefaultEmailTitle),
 		"email_intro":                  getEnvFromFile(envMap, "EMAIL_INTRO", DefaultEmailIntro),
@@ -734,6 +1002,17 @@ func configHandler(w http.ResponseWriter, r *http.Request) {
 		"watched_series_heading":       getEnvFromFile(envMap, "WATCHED_SERIES_HEADING", DefaultWatchedSeriesHeading),
 		"anticipated_movies_heading":   getEnvFromFile(envMap, "ANTICIPATED_MOVIES_HEADING", DefaultAnticipatedMoviesHeading),
 		"watched_movies_heading":       getEnvFromFile(envMap, "WATCHED_MOVIES_HEADING", DefaultWatchedMoviesHeading),
+		"most_watched_heading":         getEnvFromFile(envMap, "MOST_WATCHED_HEADING", DefaultMostWatchedHeading),
+		"recently_added_heading":       getEnvFromFile(envMap, "RECENTLY_ADDED_HEADING", DefaultRecentlyAddedHeading),
+		"music_heading":                getEnvFromFile(envMap, "MUSIC_HEADING", DefaultMusicHeading),
+		"books_heading":                getEnvFromFile(envMap, "BOOKS_HEADING", DefaultBooksHeading),
+		"pending_requests_heading":     getEnvFromFile(envMap, "PENDING_REQUESTS_HEADING", DefaultPendingRequestsHeading),
+		"server_stats_heading":         getEnvFromFile(envMap, "SERVER_STATS_HEADING", DefaultServerStatsHeading),
+		"queue_heading":                getEnvFromFile(envMap, "QUEUE_HEADING", DefaultQueueHeading),
+		"missing_heading":              getEnvFromFile(envMap, "MISSING_HEADING", DefaultMissingHeading),
+		"trakt_watchlist_heading":      getEnvFromFile(envMap, "TRAKT_WATCHLIST_HEADING", DefaultTraktWatchlistHeading),
+		"recommendations_heading":      getEnvFromFile(envMap, "RECOMMENDATIONS_HEADING", DefaultRecommendationsHeading),
+		"trakt_calendar_heading":       getEnvFromFile(envMap, "TRAKT_CALENDAR_HEADING", DefaultTraktCalendarHeading),
 		"footer_text":                  getEnvFromFile(envMap, "FOOTER_TEXT", DefaultFooterText),
 		// Monthly email string customization
 		"monthly_email_title":                  getEnvFromFile(envMap, "MONTHLY_EMAIL_TITLE", DefaultMonthlyEmailTitle),
@@ -751,8 +1030,75 @@ func configHandler(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// saveArrInstances replaces the numbered PREFIX_N_* keys with the submitted additional instances.
+// Unused slots are blanked rather than deleted so system environment variables can't resurrect them.
+// A masked API key keeps the saved key of the instance with the same URL.
+func saveArrInstances(envMap map[string]string, prefix string, submitted, saved []ArrInstance) {
+	const maskedPlaceholder = "••••••••"
+
+	for n := 2; n <= MaxArrInstances; n++ {
+		for _, suffix := range []string{"_NAME", "_URL", "_API_KEY"} {
+			key := fmt.Sprintf("%s_%d%s", prefix, n, suffix)
+			if _, exists := envMap[key]; exists || os.Getenv(key) != "" {
+				envMap[key] = ""
+			}
+		}
+	}
+
+	n := 2
+	for _, inst := range submitted {
+		if inst.URL == "" && inst.APIKey == "" {
+			continue
+		}
+		if n > MaxArrInstances {
+			log.Printf("⚠️  Only %d %s instances are supported, ignoring the rest", MaxArrInstances, prefix)
+			break
+		}
+		if inst.APIKey == maskedPlaceholder {
+			inst.APIKey = findInstanceAPIKey(saved, inst.URL)
+		}
+		key := fmt.Sprintf("%s_%d", prefix, n)
+		envMap[key+"_NAME"] = sanitizeHeader(inst.Name)
+		envMap[key+"_URL"] = inst.URL
+		envMap[key+"_API_KEY"] = inst.APIKey
+		n++
+	}
+}
+
+// saveTraktLists replaces the TRAKT_LIST_N_* keys with the submitted custom list sections.
+// Unused slots are blanked rather than deleted so system environment variables can't resurrect them.
+func saveTraktLists(envMap map[string]string, lists []TraktList) {
+	for n := 1; n <= MaxTraktLists; n++ {
+		for _, suffix := range []string{"_HEADING", "_URL", "_LIMIT"} {
+			key := fmt.Sprintf("TRAKT_LIST_%d%s", n, suffix)
+			if _, exists := envMap[key]; exists || os.Getenv(key) != "" {
+				envMap[key] = ""
+			}
+		}
+	}
+
+	n := 1
+	for _, list := range lists {
+		if list.URL == "" {
+			continue
+		}
+		if n > MaxTraktLists {
+			log.Printf("⚠️  Only %d Trakt lists are supported, ignoring the rest", MaxTraktLists)
+			break
+		}
+		key := fmt.Sprintf("TRAKT_LIST_%d", n)
+		envMap[key+"_HEADING"] = sanitizeHeader(list.Heading)
+		envMap[key+"_URL"] = list.URL
+		if list.Limit > 0 {
+			envMap[key+"_LIMIT"] = fmt.Sprintf("%d", list.Limit)
+		}
+		n++
+	}
+}
+
 // Generic API test handler - eliminates 74 lines of duplication
-func testAPIHandler(w http.ResponseWriter, r *http.Request, serviceName string) {
+// apiVersion is "v3" for Sonarr/Radarr and "v1" for Lidarr/Readarr
+func testAPIHandler(w http.ResponseWriter, r *http.Request, serviceName, apiVersion string) {
 	const maskedPlaceholder = "••••••••"
 
 	var req struct {
@@ -764,21 +1110,27 @@ func testAPIHandler(w http.ResponseWriter, r *http.Request, serviceName string)
 		return
 	}
 
-	// If API key is masked, load the real one from .env
+	// If API key is masked, look up the real one from the saved instance with the same URL
 	if req.APIKey == maskedPlaceholder {
-		envMap := readEnvFile()
-		if serviceName == "Sonarr" {
-			req.APIKey = getEnvFromFile(envMap, "SONARR_API_KEY", "")
-		} else if serviceName == "Radarr" {
-			req.APIKey = getEnvFromFile(envMap, "RADARR_API_KEY", "")
+		cfg := getConfig()
+		switch serviceName {
+		case "Sonarr":
+			req.APIKey = findInstanceAPIKey(cfg.SonarrInstances, req.URL)
+		case "Radarr":
+			req.APIKey = findInstanceAPIKey(cfg.RadarrInstances, req.URL)
+		case "Readarr":
+			req.APIKey = findInstanceAPIKey(cfg.ReadarrInstances, req.URL)
+		case "Lidarr":
+			req.APIKey = findInstanceAPIKey([]ArrInstance{{URL: cfg.LidarrURL, APIKey: cfg.LidarrAPIKey}}, req.URL)
 		}
 	}
+	req.URL = strings.TrimSuffix(req.URL, "/")
 
 	success := false
 	message := "Missing URL or API key"
 
 	if req.URL != "" && req.APIKey != "" {
-		httpReq, err := http.NewRequest("GET", req.URL+"/api/v3/system/status", nil)
+		httpReq, err := http.NewRequest("GET", req.URL+"/api/"+apiVersion+"/system/status", nil)
 		if err == nil {
 			httpReq.Header.Set("X-Api-Key", req.APIKey)
 			resp, err := httpClient.Do(httpReq)
@@ -802,12 +1154,31 @@ func testAPIHandler(w http.ResponseWriter, r *http.Request, serviceName string)
 	})
 }
 
+// findInstanceAPIKey returns the saved API key of the instance with the given URL
+func findInstanceAPIKey(instances []ArrInstance, url string) string {
+	url = strings.TrimSuffix(url, "/")
+	for _, inst := range instances {
+		if inst.URL == url {
+			return inst.APIKey
+		}
+	}
+	return ""
+}
+
 func testSonarrHandler(w http.ResponseWriter, r *http.Request) {
-	testAPIHandler(w, r, "Sonarr")
+	testAPIHandler(w, r, "Sonarr", "v3")
 }
 
 func testRadarrHandler(w http.ResponseWriter, r *http.Request) {
-	testAPIHandler(w, r, "Radarr")
+	testAPIHandler(w, r, "Radarr", "v3")
+}
+
+func testLidarrHandler(w http.ResponseWriter, r *http.Request) {
+	testAPIHandler(w, r, "Lidarr", "v1")
+}
+
+func testReadarrHandler(w http.ResponseWriter, r *http.Request) {
+	testAPIHandler(w, r, "Readarr", "v1")
 }
 
 func testTraktHandler(w http.ResponseWriter, r *http.Request) {
@@ -864,72 +1235,96 @@ func testTraktHandler(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-func testEmailHandler(w http.ResponseWriter, r *http.Request) {
+// traktDeviceCodeHandler starts the Trakt device login and returns the code the user enters on trakt.tv
+func traktDeviceCodeHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 15*time.Second)
+	defer cancel()
+
+	code, err := startTraktDeviceLogin(ctx, getConfig())
+	w.Header().Set("Content-Type", "application/json")
+	if err != nil {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": false,
+			"message": err.Error(),
+		})
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success":          true,
+		"user_code":        code.UserCode,
+		"verification_url": code.VerificationURL,
+		"expires_in":       code.ExpiresIn,
+		"interval":         code.Interval,
+	})
+}
+
+// traktDevicePollHandler reports whether the user approved the pending Trakt device login
+func traktDevicePollHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 15*time.Second)
+	defer cancel()
+
+	status, err := pollTraktDeviceLogin(ctx, getConfig())
+	response := map[string]interface{}{
+		"success": status == "authorized",
+		"status":  status,
+	}
+	if err != nil {
+		response["message"] = err.Error()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// traktDisconnectHandler forgets the connected Trakt account
+func traktDisconnectHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 15*time.Second)
+	defer cancel()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := disconnectTrakt(ctx, getConfig()); err != nil {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": false,
+			"message": fmt.Sprintf("Failed to disconnect Trakt: %v", err),
+		})
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": "Trakt account disconnected",
+	})
+}
+
+func testTMDBHandler(w http.ResponseWriter, r *http.Request) {
 	const maskedPlaceholder = "••••••••"
 
 	var req struct {
-		SMTP string `json:"smtp"`
-		Port string `json:"port"`
-		User string `json:"user"`
-		Pass string `json:"pass"`
+		APIKey string `json:"api_key"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	// If password is masked, load the real one from .env
-	if req.Pass == maskedPlaceholder {
+	// If API key is masked, load the real one from .env
+	if req.APIKey == maskedPlaceholder {
 		envMap := readEnvFile()
-		req.Pass = getEnvFromFile(envMap, "SMTP_PASS", "")
+		req.APIKey = getEnvFromFileOnly(envMap, "TMDB_API_KEY", "")
 	}
 
 	success := false
-	message := "SMTP credentials missing"
-
-	if req.User != "" && req.Pass != "" {
-		addr := fmt.Sprintf("%s:%s", req.SMTP, req.Port)
+	message := "Missing API key"
 
-		client, err := smtp.Dial(addr)
-		if err != nil {
+	if req.APIKey != "" {
+		var configuration struct {
+			Images struct {
+				SecureBaseURL string `json:"secure_base_url"`
+			} `json:"images"`
+		}
+		if err := tmdbGet(r.Context(), req.APIKey, "/configuration", nil, &configuration); err != nil {
 			message = fmt.Sprintf("Connection failed: %v", err)
 		} else {
-			defer client.Close()
-
-			if err = client.Hello("localhost"); err != nil {
-				message = fmt.Sprintf("EHLO failed: %v", err)
-			} else if ok, _ := client.Extension("STARTTLS"); ok {
-				// Secure TLS configuration - require TLS 1.2+ and strong ciphers
-				config := &tls.Config{
-					ServerName: req.SMTP,
-					MinVersion: tls.VersionTLS12,
-					CipherSuites: []uint16{
-						tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
-						tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
-						tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
-						tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
-					},
-				}
-				if err = client.StartTLS(config); err != nil {
-					message = fmt.Sprintf("STARTTLS failed: %v", err)
-				} else {
-					auth := smtp.PlainAuth("", req.User, req.Pass, req.SMTP)
-					if err = client.Auth(auth); err != nil {
-						message = fmt.Sprintf("Authentication failed: %v", err)
-					} else {
-						success = true
-						message = "SMTP authentication successful (with STARTTLS)"
-					}
-				}
-			} else {
-				auth := smtp.PlainAuth("", req.User, req.Pass, req.SMTP)
-				if err = client.Auth(auth); err != nil {
-					message = fmt.Sprintf("Authentication failed: %v", err)
-				} else {
-					success = true
-					message = "SMTP authentication successful"
-				}
-			}
+			success = true
+			message = "TMDB connection successful!"
 		}
 	}
 
@@ -940,19 +1335,627 @@ func testEmailHandler(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-func sendHandler(w http.ResponseWriter, r *http.Request) {
-	// Send immediately
-	go runNewsletter()
-
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"success": true,
-		"message": "Newsletter generation started",
-	})
-}
+func testJellyfinHandler(w http.ResponseWriter, r *http.Request) {
+	const maskedPlaceholder = "••••••••"
 
-func logsHandler(w http.ResponseWriter, r *http.Request) {
-	logBufferMu.Lock()
+	var req struct {
+		URL        string `json:"url"`
+		APIKey     string `json:"api_key"`
+		ServerType string `json:"server_type"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// If API key is masked, load the real one from .env
+	if req.APIKey == maskedPlaceholder {
+		envMap := readEnvFile()
+		req.APIKey = getEnvFromFileOnly(envMap, "JELLYFIN_API_KEY", "")
+	}
+
+	serverName := jellyfinServerName(req.ServerType)
+	success := false
+	message := "Missing URL or API key"
+
+	if req.URL != "" && req.APIKey != "" {
+		httpReq, err := newJellyfinRequest(r.Context(), "GET", req.URL, req.APIKey, "/System/Info", nil)
+		if err == nil {
+			resp, err := httpClient.Do(httpReq)
+			if err != nil {
+				message = fmt.Sprintf("Connection failed: %v", err)
+			} else if resp.StatusCode == 200 {
+				var info struct {
+					ServerName string `json:"ServerName"`
+					Version    string `json:"Version"`
+				}
+				json.NewDecoder(resp.Body).Decode(&info)
+				success = true
+				message = fmt.Sprintf("%s connection successful!", serverName)
+				if info.Version != "" {
+					message = fmt.Sprintf("%s connection successful! (%s, v%s)", serverName, info.ServerName, info.Version)
+				}
+				resp.Body.Close()
+			} else if resp.StatusCode == 401 {
+				message = "Invalid API key"
+				resp.Body.Close()
+			} else {
+				message = fmt.Sprintf("Connection failed: HTTP %d", resp.StatusCode)
+				resp.Body.Close()
+			}
+		} else {
+			message = fmt.Sprintf("Failed to create request: %v", err)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": success,
+		"message": message,
+	})
+}
+
+func testPlexHandler(w http.ResponseWriter, r *http.Request) {
+	const maskedPlaceholder = "••••••••"
+
+	var req struct {
+		URL   string `json:"url"`
+		Token string `json:"token"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// If token is masked, load the real one from .env
+	if req.Token == maskedPlaceholder {
+		envMap := readEnvFile()
+		req.Token = getEnvFromFileOnly(envMap, "PLEX_TOKEN", "")
+	}
+
+	success := false
+	message := "Missing URL or token"
+
+	if req.URL != "" && req.Token != "" {
+		httpReq, err := newPlexRequest(r.Context(), req.URL, req.Token, "/library/sections")
+		if err == nil {
+			resp, err := httpClient.Do(httpReq)
+			if err != nil {
+				message = fmt.Sprintf("Connection failed: %v", err)
+			} else if resp.StatusCode == 200 {
+				var container plexMediaContainer
+				json.NewDecoder(resp.Body).Decode(&container)
+				success = true
+				message = fmt.Sprintf("Plex connection successful! (%d libraries)", len(container.MediaContainer.Directory))
+				resp.Body.Close()
+			} else if resp.StatusCode == 401 {
+				message = "Invalid token"
+				resp.Body.Close()
+			} else {
+				message = fmt.Sprintf("Connection failed: HTTP %d", resp.StatusCode)
+				resp.Body.Close()
+			}
+		} else {
+			message = fmt.Sprintf("Failed to create request: %v", err)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": success,
+		"message": message,
+	})
+}
+
+func testTautulliHandler(w http.ResponseWriter, r *http.Request) {
+	const maskedPlaceholder = "••••••••"
+
+	var req struct {
+		URL    string `json:"url"`
+		APIKey string `json:"api_key"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// If API key is masked, load the real one from .env
+	if req.APIKey == maskedPlaceholder {
+		envMap := readEnvFile()
+		req.APIKey = getEnvFromFileOnly(envMap, "TAUTULLI_API_KEY", "")
+	}
+
+	success := false
+	message := "Missing URL or API key"
+
+	if req.URL != "" && req.APIKey != "" {
+		var info struct {
+			Version string `json:"tautulli_version"`
+		}
+		if err := tautulliGet(r.Context(), req.URL, req.APIKey, "get_tautulli_info", nil, &info); err != nil {
+			message = fmt.Sprintf("Connection failed: %v", err)
+		} else {
+			success = true
+			message = fmt.Sprintf("Tautulli connection successful! (%s)", info.Version)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": success,
+		"message": message,
+	})
+}
+
+func testBazarrHandler(w http.ResponseWriter, r *http.Request) {
+	const maskedPlaceholder = "••••••••"
+
+	var req struct {
+		URL    string `json:"url"`
+		APIKey string `json:"api_key"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// If API key is masked, load the real one from .env
+	if req.APIKey == maskedPlaceholder {
+		envMap := readEnvFile()
+		req.APIKey = getEnvFromFileOnly(envMap, "BAZARR_API_KEY", "")
+	}
+
+	success := false
+	message := "Missing URL or API key"
+
+	if req.URL != "" && req.APIKey != "" {
+		var status struct {
+			Version string `json:"bazarr_version"`
+		}
+		if err := bazarrGet(r.Context(), req.URL, req.APIKey, "/system/status", nil, &status); err != nil {
+			message = fmt.Sprintf("Connection failed: %v", err)
+		} else {
+			success = true
+			message = fmt.Sprintf("Bazarr connection successful! (v%s)", status.Version)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": success,
+		"message": message,
+	})
+}
+
+func testOverseerrHandler(w http.ResponseWriter, r *http.Request) {
+	const maskedPlaceholder = "••••••••"
+
+	var req struct {
+		URL    string `json:"url"`
+		APIKey string `json:"api_key"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// If API key is masked, load the real one from .env
+	if req.APIKey == maskedPlaceholder {
+		envMap := readEnvFile()
+		req.APIKey = getEnvFromFileOnly(envMap, "OVERSEERR_API_KEY", "")
+	}
+
+	success := false
+	message := "Missing URL or API key"
+
+	if req.URL != "" && req.APIKey != "" {
+		// /api/v1/status is public, so use an endpoint that actually checks the API key
+		httpReq, err := newOverseerrRequest(r.Context(), req.URL, req.APIKey, "/api/v1/request/count")
+		if err == nil {
+			resp, err := httpClient.Do(httpReq)
+			if err != nil {
+				message = fmt.Sprintf("Connection failed: %v", err)
+			} else if resp.StatusCode == 200 {
+				var counts struct {
+					Total int `json:"total"`
+				}
+				json.NewDecoder(resp.Body).Decode(&counts)
+				success = true
+				message = fmt.Sprintf("Overseerr connection successful! (%d requests)", counts.Total)
+				resp.Body.Close()
+			} else if resp.StatusCode == 401 || resp.StatusCode == 403 {
+				message = "Invalid API key"
+				resp.Body.Close()
+			} else {
+				message = fmt.Sprintf("Connection failed: HTTP %d", resp.StatusCode)
+				resp.Body.Close()
+			}
+		} else {
+			message = fmt.Sprintf("Failed to create request: %v", err)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": success,
+		"message": message,
+	})
+}
+
+func testEmailHandler(w http.ResponseWriter, r *http.Request) {
+	const maskedPlaceholder = "••••••••"
+
+	var req struct {
+		SMTP       string `json:"smtp"`
+		Port       string `json:"port"`
+		User       string `json:"user"`
+		Pass       string `json:"pass"`
+		Security   string `json:"security"`
+		CAFile     string `json:"ca_file"`
+		SkipVerify string `json:"skip_verify"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// If password is masked, load the real one from .env
+	if req.Pass == maskedPlaceholder {
+		envMap := readEnvFile()
+		req.Pass = getEnvFromFile(envMap, "SMTP_PASS", "")
+	}
+
+	success := false
+	message := "SMTP server and port missing"
+
+	if req.SMTP != "" && req.Port != "" {
+		// Same connection code as the newsletter, with the settings from the form,
+		// it only authenticates when a user is set
+		client, security, err := dialSMTP(&Config{
+			SMTPHost:          req.SMTP,
+			SMTPPort:          req.Port,
+			SMTPUser:          req.User,
+			SMTPPass:          req.Pass,
+			SMTPSecurity:      req.Security,
+			SMTPCAFile:        req.CAFile,
+			SMTPTLSSkipVerify: req.SkipVerify == "true",
+		})
+		if err != nil {
+			message = fmt.Sprintf("SMTP test failed: %v", err)
+		} else {
+			client.Quit()
+			success = true
+			if req.User != "" {
+				message = fmt.Sprintf("SMTP authentication successful (%s)", security)
+			} else {
+				message = fmt.Sprintf("SMTP connection successful (%s, no authentication)", security)
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": success,
+		"message": message,
+	})
+}
+
+func testSlackHandler(w http.ResponseWriter, r *http.Request) {
+	const maskedPlaceholder = "••••••••"
+
+	var req struct {
+		WebhookURL string `json:"webhook_url"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// If the webhook is masked, load the real one from .env
+	if req.WebhookURL == maskedPlaceholder {
+		envMap := readEnvFile()
+		req.WebhookURL = getEnvFromFileOnly(envMap, "SLACK_WEBHOOK_URL", "")
+	}
+
+	success := false
+	message := "Missing webhook URL"
+
+	if req.WebhookURL != "" {
+		msg := slackMessage{
+			Text:     "✅ Newslettar test message - Slack delivery is working!",
+			Username: getConfig().FromName,
+			Blocks:   []slackBlock{{Type: "section", Text: &slackText{Type: "mrkdwn", Text: "✅ *Newslettar* test message - Slack delivery is working!"}}},
+		}
+		if err := pushRequest(r.Context(), "POST", req.WebhookURL, nil, msg); err != nil {
+			message = fmt.Sprintf("Slack test failed: %v", err)
+		} else {
+			success = true
+			message = "Test message posted to Slack!"
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": success,
+		"message": message,
+	})
+}
+
+func testDiscordHandler(w http.ResponseWriter, r *http.Request) {
+	const maskedPlaceholder = "••••••••"
+
+	var req struct {
+		WebhookURL string `json:"webhook_url"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// If the webhook is masked, load the real one from .env
+	if req.WebhookURL == maskedPlaceholder {
+		envMap := readEnvFile()
+		req.WebhookURL = getEnvFromFileOnly(envMap, "DISCORD_WEBHOOK_URL", "")
+	}
+
+	success := false
+	message := "Missing webhook URL"
+
+	if req.WebhookURL != "" {
+		msg := discordMessage{Username: getConfig().FromName, Content: "✅ Newslettar test message - Discord delivery is working!"}
+		if err := postDiscordWebhook(r.Context(), req.WebhookURL, msg); err != nil {
+			message = fmt.Sprintf("Discord test failed: %v", err)
+		} else {
+			success = true
+			message = "Test message posted to Discord!"
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": success,
+		"message": message,
+	})
+}
+
+func testTelegramHandler(w http.ResponseWriter, r *http.Request) {
+	const maskedPlaceholder = "••••••••"
+
+	var req struct {
+		Token   string `json:"token"`
+		ChatIDs string `json:"chat_ids"`
+		APIURL  string `json:"api_url"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// If the token is masked, load the real one from .env
+	if req.Token == maskedPlaceholder {
+		envMap := readEnvFile()
+		req.Token = getEnvFromFileOnly(envMap, "TELEGRAM_BOT_TOKEN", "")
+	}
+	if req.APIURL == "" {
+		req.APIURL = DefaultTelegramAPIURL
+	}
+
+	success := false
+	message := "Missing bot token"
+
+	if req.Token != "" {
+		var bot struct {
+			Username string `json:"username"`
+		}
+		if err := telegramCall(r.Context(), req.APIURL, req.Token, "getMe", map[string]interface{}{}, &bot); err != nil {
+			message = fmt.Sprintf("Telegram connection failed: %v", err)
+		} else {
+			success = true
+			message = fmt.Sprintf("Connected as @%s", bot.Username)
+
+			// Post a test message to every chat so wrong chat IDs show up now
+			cfg := &Config{TelegramBotToken: req.Token, TelegramAPIURL: req.APIURL}
+			chatIDs := parseList(req.ChatIDs)
+			for _, chatID := range chatIDs {
+				if err := telegramSendMessage(r.Context(), cfg, chatID, telegramEscape("✅ Newslettar test message - Telegram delivery is working!")); err != nil {
+					success = false
+					message = fmt.Sprintf("Connected as @%s, but chat %s failed: %v", bot.Username, chatID, err)
+					break
+				}
+			}
+			if success && len(chatIDs) > 0 {
+				message += fmt.Sprintf(" - test message sent to %d chat(s)", len(chatIDs))
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": success,
+		"message": message,
+	})
+}
+
+// pushTestMessage is the notification sent by the push service test endpoints
+const pushTestMessage = "✅ Newslettar test message - notifications are working!"
+
+func testMatrixHandler(w http.ResponseWriter, r *http.Request) {
+	const maskedPlaceholder = "••••••••"
+
+	var req struct {
+		HomeserverURL string `json:"homeserver_url"`
+		AccessToken   string `json:"access_token"`
+		RoomID        string `json:"room_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// If the access token is masked, load the real one from .env
+	if req.AccessToken == maskedPlaceholder {
+		envMap := readEnvFile()
+		req.AccessToken = getEnvFromFileOnly(envMap, "MATRIX_ACCESS_TOKEN", "")
+	}
+
+	success := false
+	message := "Missing homeserver URL, access token or room ID"
+
+	if req.HomeserverURL != "" && req.AccessToken != "" && req.RoomID != "" {
+		n := matrixNotifier{cfg: &Config{
+			MatrixHomeserverURL: strings.TrimSuffix(req.HomeserverURL, "/"),
+			MatrixAccessToken:   req.AccessToken,
+			MatrixRoomID:        req.RoomID,
+		}}
+		if err := n.push(r.Context(), "Newslettar", pushTestMessage, ""); err != nil {
+			message = fmt.Sprintf("Matrix test failed: %v", err)
+		} else {
+			success = true
+			message = "Test message posted to the Matrix room!"
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": success,
+		"message": message,
+	})
+}
+
+func testNtfyHandler(w http.ResponseWriter, r *http.Request) {
+	const maskedPlaceholder = "••••••••"
+
+	var req struct {
+		URL   string `json:"url"`
+		Topic string `json:"topic"`
+		Token string `json:"token"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// If the token is masked, load the real one from .env
+	if req.Token == maskedPlaceholder {
+		envMap := readEnvFile()
+		req.Token = getEnvFromFileOnly(envMap, "NTFY_TOKEN", "")
+	}
+	if req.URL == "" {
+		req.URL = DefaultNtfyURL
+	}
+
+	success := false
+	message := "Missing topic"
+
+	if req.Topic != "" {
+		n := ntfyNotifier{cfg: &Config{NtfyURL: strings.TrimSuffix(req.URL, "/"), NtfyTopic: req.Topic, NtfyToken: req.Token}}
+		if err := n.push(r.Context(), "Newslettar", pushTestMessage, ""); err != nil {
+			message = fmt.Sprintf("ntfy test failed: %v", err)
+		} else {
+			success = true
+			message = "Test notification published to ntfy!"
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": success,
+		"message": message,
+	})
+}
+
+func testGotifyHandler(w http.ResponseWriter, r *http.Request) {
+	const maskedPlaceholder = "••••••••"
+
+	var req struct {
+		URL   string `json:"url"`
+		Token string `json:"token"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// If the token is masked, load the real one from .env
+	if req.Token == maskedPlaceholder {
+		envMap := readEnvFile()
+		req.Token = getEnvFromFileOnly(envMap, "GOTIFY_TOKEN", "")
+	}
+
+	success := false
+	message := "Missing URL or application token"
+
+	if req.URL != "" && req.Token != "" {
+		n := gotifyNotifier{cfg: &Config{GotifyURL: strings.TrimSuffix(req.URL, "/"), GotifyToken: req.Token}}
+		if err := n.push(r.Context(), "Newslettar", pushTestMessage, ""); err != nil {
+			message = fmt.Sprintf("Gotify test failed: %v", err)
+		} else {
+			success = true
+			message = "Test message sent to Gotify!"
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": success,
+		"message": message,
+	})
+}
+
+func testWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	const maskedPlaceholder = "••••••••"
+
+	var req struct {
+		URL    string `json:"url"`
+		Secret string `json:"secret"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// If the secret is masked, load the real one from .env
+	if req.Secret == maskedPlaceholder {
+		envMap := readEnvFile()
+		req.Secret = getEnvFromFileOnly(envMap, "WEBHOOK_SECRET", "")
+	}
+
+	success := false
+	message := "Missing webhook URL"
+
+	if req.URL != "" {
+		payload := map[string]string{"event": webhookEventTest, "message": pushTestMessage}
+		if err := postWebhook(r.Context(), req.URL, req.Secret, webhookEventTest, payload); err != nil {
+			message = fmt.Sprintf("Webhook test failed: %v", err)
+		} else {
+			success = true
+			message = "Test event delivered to the webhook!"
+			if req.Secret == "" {
+				message += " (unsigned - set a secret to sign requests)"
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": success,
+		"message": message,
+	})
+}
+
+func sendHandler(w http.ResponseWriter, r *http.Request) {
+	// Send immediately
+	go runNewsletter()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": "Newsletter generation started",
+	})
+}
+
+func logsHandler(w http.ResponseWriter, r *http.Request) {
+	logBufferMu.Lock()
 	defer logBufferMu.Unlock()
 
 	w.Header().Set("Content-Type", "text/plain")
@@ -1037,23 +2040,21 @@ func dashboardHandler(w http.ResponseWriter, r *http.Request) {
 	// Check service status (config only - no API calls for performance)
 	serviceStatus := make(map[string]string)
 
-	// Check Sonarr configuration
-	if cfg.SonarrURL != "" && cfg.SonarrAPIKey != "" {
-		serviceStatus["sonarr"] = "configured"
-	} else {
-		serviceStatus["sonarr"] = "not_configured"
+	// Check Sonarr, Radarr and Readarr configuration (overall status plus one "service:name" entry per instance)
+	for service, instances := range map[string][]ArrInstance{"sonarr": cfg.SonarrInstances, "radarr": cfg.RadarrInstances, "readarr": cfg.ReadarrInstances} {
+		status, perInstance := instanceStatuses(service, instances)
+		serviceStatus[service] = status
+		for key, instanceStatus := range perInstance {
+			serviceStatus[key] = instanceStatus
+		}
 	}
 
-	// Check Radarr configuration
-	if cfg.RadarrURL != "" && cfg.RadarrAPIKey != "" {
-		serviceStatus["radarr"] = "configured"
-	} else {
-		serviceStatus["radarr"] = "not_configured"
-	}
+	// Check Lidarr configuration
+	serviceStatus["lidarr"] = connectionStatus(cfg.LidarrURL, cfg.LidarrAPIKey)
 
 	// Check Email configuration
 	// Show as configured if SMTP settings are present (recipients can be added later)
-	if cfg.SMTPHost != "" && cfg.SMTPPort != "" && cfg.SMTPUser != "" && cfg.SMTPPass != "" && cfg.FromEmail != "" {
+	if cfg.SMTPHost != "" && cfg.SMTPPort != "" && cfg.FromEmail != "" && (cfg.SMTPUser == "" || cfg.SMTPPass != "") {
 		serviceStatus["email"] = "configured"
 	} else if cfg.SMTPHost != "" || cfg.SMTPPort != "" || cfg.FromEmail != "" {
 		serviceStatus["email"] = "misconfigured" // Partially configured
@@ -1061,6 +2062,49 @@ func dashboardHandler(w http.ResponseWriter, r *http.Request) {
 		serviceStatus["email"] = "not_configured"
 	}
 
+	// Check Slack configuration
+	if cfg.SlackWebhookURL != "" {
+		serviceStatus["slack"] = "configured"
+	} else {
+		serviceStatus["slack"] = "not_configured"
+	}
+
+	// Check Discord configuration
+	if cfg.DiscordWebhookURL != "" {
+		serviceStatus["discord"] = "configured"
+	} else {
+		serviceStatus["discord"] = "not_configured"
+	}
+
+	// Check Telegram configuration
+	if cfg.TelegramBotToken != "" && len(cfg.TelegramChatIDs) > 0 {
+		serviceStatus["telegram"] = "configured"
+	} else if cfg.TelegramBotToken != "" || len(cfg.TelegramChatIDs) > 0 {
+		serviceStatus["telegram"] = "misconfigured"
+	} else {
+		serviceStatus["telegram"] = "not_configured"
+	}
+
+	// Check push notification configuration
+	if cfg.MatrixHomeserverURL != "" && cfg.MatrixAccessToken != "" && cfg.MatrixRoomID != "" {
+		serviceStatus["matrix"] = "configured"
+	} else if cfg.MatrixHomeserverURL != "" || cfg.MatrixAccessToken != "" || cfg.MatrixRoomID != "" {
+		serviceStatus["matrix"] = "misconfigured"
+	} else {
+		serviceStatus["matrix"] = "not_configured"
+	}
+	if cfg.NtfyTopic != "" {
+		serviceStatus["ntfy"] = "configured"
+	} else {
+		serviceStatus["ntfy"] = "not_configured"
+	}
+	serviceStatus["gotify"] = connectionStatus(cfg.GotifyURL, cfg.GotifyToken)
+	if cfg.WebhookURL != "" {
+		serviceStatus["webhook"] = "configured"
+	} else {
+		serviceStatus["webhook"] = "not_configured"
+	}
+
 	// Check Trakt configuration
 	if cfg.TraktClientID != "" {
 		serviceStatus["trakt"] = "configured"
@@ -1068,6 +2112,19 @@ func dashboardHandler(w http.ResponseWriter, r *http.Request) {
 		serviceStatus["trakt"] = "not_configured"
 	}
 
+	// Check Jellyfin/Emby and Plex configuration
+	serviceStatus["jellyfin"] = connectionStatus(cfg.JellyfinURL, cfg.JellyfinAPIKey)
+	serviceStatus["plex"] = connectionStatus(cfg.PlexURL, cfg.PlexToken)
+
+	// Check Tautulli configuration
+	serviceStatus["tautulli"] = connectionStatus(cfg.TautulliURL, cfg.TautulliAPIKey)
+
+	// Check Bazarr configuration
+	serviceStatus["bazarr"] = connectionStatus(cfg.BazarrURL, cfg.BazarrAPIKey)
+
+	// Check Overseerr/Jellyseerr configuration
+	serviceStatus["overseerr"] = connectionStatus(cfg.OverseerrURL, cfg.OverseerrAPIKey)
+
 	dashboard := DashboardData{
 		Version:          version,
 		Uptime:           uptimeStr,
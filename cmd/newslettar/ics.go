@@ -0,0 +1,303 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Upcoming episodes and movie releases as iCalendar (RFC 5545), served as a subscribable
+// feed at /calendar.ics and optionally attached to the newsletter email.
+// Episodes with a known air time become timed events in the configured timezone,
+// everything else (and all movies, Radarr only reports dates) becomes all-day events.
+
+const (
+	icsProdID        = "-//Newslettar//Upcoming releases//EN"
+	icsDateFormat    = "20060102"
+	icsLocalFormat   = "20060102T150405"
+	icsUTCFormat     = "20060102T150405Z"
+	icsLineLimit     = 75 // Octets per content line before folding
+	icsDefaultLength = 30 * time.Minute
+)
+
+// icsEscape escapes a TEXT property value
+func icsEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", "").Replace(s)
+}
+
+// icsWriter writes CRLF-terminated content lines, folded at 75 octets without splitting UTF-8 sequences
+type icsWriter struct {
+	b strings.Builder
+}
+
+func (w *icsWriter) line(name, value string) {
+	content := name + ":" + value
+	for len(content) > icsLineLimit {
+		cut := icsLineLimit
+		for cut > 0 && content[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.b.WriteString(content[:cut] + "\r\n")
+		content = " " + content[cut:]
+	}
+	w.b.WriteString(content + "\r\n")
+}
+
+// writeVTimezone describes loc with every offset change between start and end,
+// so clients don't need to know the IANA name to place timed events correctly
+func (w *icsWriter) writeVTimezone(loc *time.Location, start, end time.Time) {
+	w.line("BEGIN", "VTIMEZONE")
+	w.line("TZID", loc.String())
+
+	observance := func(t time.Time, from int) {
+		name, offset := t.Zone()
+		kind := "STANDARD"
+		if t.IsDST() {
+			kind = "DAYLIGHT"
+		}
+		w.line("BEGIN", kind)
+		// DTSTART is the onset in the local time that was in effect before it
+		w.line("DTSTART", t.UTC().Add(time.Duration(from)*time.Second).Format(icsLocalFormat))
+		w.line("TZOFFSETFROM", icsOffset(from))
+		w.line("TZOFFSETTO", icsOffset(offset))
+		if name != "" && !strings.HasPrefix(name, "+") && !strings.HasPrefix(name, "-") {
+			w.line("TZNAME", name)
+		}
+		w.line("END", kind)
+	}
+
+	t := start.In(loc)
+	zoneStart, _ := t.ZoneBounds()
+	_, prev := t.Zone()
+	if zoneStart.IsZero() {
+		zoneStart = time.Date(1970, 1, 1, 0, 0, 0, 0, loc)
+	} else {
+		_, prev = zoneStart.Add(-time.Second).Zone()
+	}
+	observance(zoneStart.In(loc), prev)
+	for {
+		_, next := t.ZoneBounds()
+		if next.IsZero() || next.After(end) {
+			break
+		}
+		_, from := t.Zone()
+		t = next.In(loc)
+		observance(t, from)
+	}
+
+	w.line("END", "VTIMEZONE")
+}
+
+// icsOffset formats a UTC offset in seconds as +HHMM
+func icsOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+}
+
+// icsEpisodeTime returns the exact air time of an episode when Sonarr reported one
+func icsEpisodeTime(ep Episode) (time.Time, bool) {
+	if ep.AirDateUTC == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, ep.AirDateUTC)
+	return t, err == nil
+}
+
+// icsDate parses a Sonarr/Radarr date ("2006-01-02" or a full timestamp) for an all-day event
+func icsDate(date string) (time.Time, bool) {
+	if len(date) < 10 {
+		return time.Time{}, false
+	}
+	t, err := time.Parse("2006-01-02", date[:10])
+	return t, err == nil
+}
+
+// buildICS renders the upcoming series and movies as an iCalendar document
+func buildICS(cfg *Config, name string, groups []SeriesGroup, movies []Movie) string {
+	loc := getTimezone(cfg.Timezone)
+	stamp := time.Now().UTC().Format(icsUTCFormat)
+
+	// Timed events need the VTIMEZONE to cover their span
+	var first, last time.Time
+	for _, group := range groups {
+		for _, ep := range group.Episodes {
+			if t, ok := icsEpisodeTime(ep); ok {
+				if first.IsZero() || t.Before(first) {
+					first = t
+				}
+				if t.After(last) {
+					last = t
+				}
+			}
+		}
+	}
+
+	var w icsWriter
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", icsProdID)
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	w.line("X-WR-CALNAME", icsEscape(name))
+	w.line("X-WR-TIMEZONE", loc.String())
+	if !first.IsZero() && loc != time.UTC {
+		w.writeVTimezone(loc, first.AddDate(0, 0, -1), last.AddDate(0, 0, 1))
+	}
+
+	for _, group := range groups {
+		for _, ep := range group.Episodes {
+			// Pick the timing first: an event that can't be placed is dropped rather than emitted invalid
+			var dates [][2]string
+			if t, ok := icsEpisodeTime(ep); ok {
+				length := icsDefaultLength
+				if ep.Runtime > 0 {
+					length = time.Duration(ep.Runtime) * time.Minute
+				}
+				if loc == time.UTC {
+					dates = [][2]string{{"DTSTART", t.UTC().Format(icsUTCFormat)}, {"DTEND", t.Add(length).UTC().Format(icsUTCFormat)}}
+				} else {
+					dates = [][2]string{
+						{"DTSTART;TZID=" + loc.String(), t.In(loc).Format(icsLocalFormat)},
+						{"DTEND;TZID=" + loc.String(), t.Add(length).In(loc).Format(icsLocalFormat)},
+					}
+				}
+			} else if day, ok := icsDate(ep.AirDate); ok {
+				dates = [][2]string{{"DTSTART;VALUE=DATE", day.Format(icsDateFormat)}, {"DTEND;VALUE=DATE", day.AddDate(0, 0, 1).Format(icsDateFormat)}}
+			} else {
+				continue
+			}
+
+			w.line("BEGIN", "VEVENT")
+			w.line("UID", fmt.Sprintf("episode-%s-s%02de%02d@newslettar", strings.ReplaceAll(seriesKey(ep), ":", "-"), ep.SeasonNum, ep.EpisodeNum))
+			w.line("DTSTAMP", stamp)
+			for _, d := range dates {
+				w.line(d[0], d[1])
+			}
+			summary := fmt.Sprintf("%s - S%02dE%02d", ep.SeriesTitle, ep.SeasonNum, ep.EpisodeNum)
+			if ep.Title != "" {
+				summary += " - " + ep.Title
+			}
+			w.line("SUMMARY", icsEscape(summary))
+			if ep.Overview != "" {
+				w.line("DESCRIPTION", icsEscape(ep.Overview))
+			}
+			w.line("CATEGORIES", "TV")
+			w.line("TRANSP", "TRANSPARENT")
+			w.line("END", "VEVENT")
+		}
+	}
+
+	for _, movie := range movies {
+		day, ok := icsDate(movie.ReleaseDate)
+		if !ok {
+			continue
+		}
+		key := movieKey(movie)
+		w.line("BEGIN", "VEVENT")
+		w.line("UID", fmt.Sprintf("movie-%s@newslettar", strings.ReplaceAll(key, ":", "-")))
+		w.line("DTSTAMP", stamp)
+		w.line("DTSTART;VALUE=DATE", day.Format(icsDateFormat))
+		w.line("DTEND;VALUE=DATE", day.AddDate(0, 0, 1).Format(icsDateFormat))
+		summary := movie.Title
+		if movie.Year > 0 {
+			summary = fmt.Sprintf("%s (%d)", movie.Title, movie.Year)
+		}
+		w.line("SUMMARY", icsEscape(summary))
+		if movie.Overview != "" {
+			w.line("DESCRIPTION", icsEscape(movie.Overview))
+		}
+		w.line("CATEGORIES", "Movie")
+		w.line("TRANSP", "TRANSPARENT")
+		w.line("END", "VEVENT")
+	}
+
+	w.line("END", "VCALENDAR")
+	return w.b.String()
+}
+
+// fetchUpcomingReleases fetches the Sonarr and Radarr calendars of every instance between start and end,
+// filtered and deduplicated the same way as the newsletter's upcoming sections
+func fetchUpcomingReleases(ctx context.Context, cfg *Config, start, end time.Time) ([]SeriesGroup, []Movie) {
+	sonarrInstances := configuredInstances(cfg.SonarrInstances)
+	radarrInstances := configuredInstances(cfg.RadarrInstances)
+	sonarrCalendar := make([][]Episode, len(sonarrInstances))
+	radarrCalendar := make([][]Movie, len(radarrInstances))
+
+	var wg sync.WaitGroup
+	for i, inst := range sonarrInstances {
+		wg.Add(1)
+		go func(i int, inst ArrInstance) {
+			defer wg.Done()
+			episodes, err := fetchSonarrCalendarWithRetry(ctx, cfg, inst, start, end, cfg.PreviewRetries)
+			if err != nil {
+				log.Printf("⚠️  %s calendar error: %v", inst.Name, err)
+				return
+			}
+			sonarrCalendar[i] = episodes
+		}(i, inst)
+	}
+	for i, inst := range radarrInstances {
+		wg.Add(1)
+		go func(i int, inst ArrInstance) {
+			defer wg.Done()
+			movies, err := fetchRadarrCalendarWithRetry(ctx, cfg, inst, start, end, cfg.PreviewRetries)
+			if err != nil {
+				log.Printf("⚠️  %s calendar error: %v", inst.Name, err)
+				return
+			}
+			radarrCalendar[i] = movies
+		}(i, inst)
+	}
+	wg.Wait()
+
+	var episodes []Episode
+	var movies []Movie
+	for i := range sonarrInstances {
+		episodes = append(episodes, sonarrCalendar[i]...)
+	}
+	for i := range radarrInstances {
+		movies = append(movies, radarrCalendar[i]...)
+	}
+	if !cfg.ShowUnmonitored {
+		episodes = filterMonitoredEpisodes(episodes)
+		movies = filterMonitoredMovies(movies)
+	}
+	movies = deduplicateMovies(movies)
+	sort.Slice(movies, func(i, j int) bool {
+		return movies[i].ReleaseDate < movies[j].ReleaseDate
+	})
+	return groupEpisodesBySeries(deduplicateEpisodes(episodes)), movies
+}
+
+// calendarHandler serves the upcoming releases as a subscribable calendar at /calendar.ics.
+// ?type=episodes or ?type=movies limits the feed to one kind, so each subscriber can pick.
+func calendarHandler(w http.ResponseWriter, r *http.Request) {
+	cfg := getConfig()
+	now := time.Now().In(getTimezone(cfg.Timezone))
+	start := now.AddDate(0, 0, -CalendarFeedPastDays)
+	end := now.AddDate(0, 0, CalendarFeedDays)
+
+	ctx, cancel := context.WithTimeout(r.Context(), time.Duration(cfg.APITimeout)*time.Second)
+	defer cancel()
+
+	groups, movies := fetchUpcomingReleases(ctx, cfg, start, end)
+	switch r.URL.Query().Get("type") {
+	case "episodes":
+		movies = nil
+	case "movies":
+		groups = nil
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="newslettar.ics"`)
+	fmt.Fprint(w, buildICS(cfg, feedTitle(cfg), groups, movies))
+}
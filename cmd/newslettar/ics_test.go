@@ -0,0 +1,133 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestICSOffset(t *testing.T) {
+	tests := []struct {
+		seconds int
+		want    string
+	}{
+		{0, "+0000"},
+		{3600, "+0100"},
+		{-5 * 3600, "-0500"},
+		{5*3600 + 1800, "+0530"},
+		{-(3*3600 + 1800), "-0330"},
+		{12*3600 + 45*60, "+1245"},
+	}
+	for _, tt := range tests {
+		if got := icsOffset(tt.seconds); got != tt.want {
+			t.Errorf("icsOffset(%d) = %q, want %q", tt.seconds, got, tt.want)
+		}
+	}
+}
+
+func TestICSWriterFolding(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"short", "Pilot"},
+		{"ascii", strings.Repeat("a", 200)},
+		{"multi-byte", strings.Repeat("é", 100)},
+		{"emoji", strings.Repeat("🎬", 50)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var w icsWriter
+			w.line("SUMMARY", tt.value)
+			out := w.b.String()
+			if !strings.HasSuffix(out, "\r\n") {
+				t.Fatalf("content line not CRLF-terminated: %q", out)
+			}
+			for _, line := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+				if len(line) > icsLineLimit {
+					t.Errorf("line of %d octets exceeds %d: %q", len(line), icsLineLimit, line)
+				}
+				if !utf8.ValidString(line) {
+					t.Errorf("folding split a UTF-8 sequence: %q", line)
+				}
+			}
+			if unfolded := strings.ReplaceAll(out, "\r\n ", ""); unfolded != "SUMMARY:"+tt.value+"\r\n" {
+				t.Errorf("unfolded line = %q, want the original", unfolded)
+			}
+		})
+	}
+}
+
+func TestBuildICS(t *testing.T) {
+	groups := []SeriesGroup{{
+		SeriesTitle: "Show",
+		Episodes: []Episode{
+			// 2025-03-09 21:30 in New York, the evening of the switch to daylight saving time
+			{SeriesTitle: "Show", TvdbID: 1, SeasonNum: 1, EpisodeNum: 2, Title: "Pilot, Part 2", AirDateUTC: "2025-03-10T01:30:00Z", Runtime: 45},
+			{SeriesTitle: "Show", TvdbID: 1, SeasonNum: 1, EpisodeNum: 3, AirDate: "2025-03-16"},
+		},
+	}}
+	movies := []Movie{{Title: "Movie", Year: 2025, TmdbID: 603, ReleaseDate: "2025-03-14"}}
+
+	tests := []struct {
+		timezone string
+		want     []string
+		notWant  []string
+	}{
+		{
+			timezone: "UTC",
+			want: []string{
+				"X-WR-TIMEZONE:UTC",
+				"DTSTART:20250310T013000Z",
+				"DTEND:20250310T021500Z",
+			},
+			notWant: []string{"BEGIN:VTIMEZONE", "TZID="},
+		},
+		{
+			timezone: "America/New_York",
+			want: []string{
+				"BEGIN:VTIMEZONE\r\nTZID:America/New_York\r\n",
+				"BEGIN:STANDARD\r\nDTSTART:20241103T020000\r\nTZOFFSETFROM:-0400\r\nTZOFFSETTO:-0500\r\nTZNAME:EST\r\nEND:STANDARD\r\n",
+				"BEGIN:DAYLIGHT\r\nDTSTART:20250309T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\nTZNAME:EDT\r\nEND:DAYLIGHT\r\n",
+				"DTSTART;TZID=America/New_York:20250309T213000",
+				"DTEND;TZID=America/New_York:20250309T221500",
+			},
+		},
+		{
+			timezone: "Asia/Kolkata",
+			want: []string{
+				"TZOFFSETTO:+0530",
+				"DTSTART;TZID=Asia/Kolkata:20250310T070000",
+			},
+			notWant: []string{"BEGIN:DAYLIGHT"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.timezone, func(t *testing.T) {
+			out := buildICS(&Config{Timezone: tt.timezone}, "Upcoming", groups, movies)
+			// Timezone-independent parts: all-day events, escaping and the calendar envelope
+			want := append(tt.want,
+				"BEGIN:VCALENDAR\r\nVERSION:2.0\r\n",
+				"UID:episode-tvdb-1-s01e02@newslettar",
+				`SUMMARY:Show - S01E02 - Pilot\, Part 2`,
+				"DTSTART;VALUE=DATE:20250316\r\nDTEND;VALUE=DATE:20250317\r\n",
+				"UID:movie-tmdb-603@newslettar",
+				"DTSTART;VALUE=DATE:20250314\r\nDTEND;VALUE=DATE:20250315\r\n",
+				"SUMMARY:Movie (2025)",
+			)
+			for _, s := range want {
+				if !strings.Contains(out, s) {
+					t.Errorf("calendar is missing %q:\n%s", s, out)
+				}
+			}
+			for _, s := range tt.notWant {
+				if strings.Contains(out, s) {
+					t.Errorf("calendar contains %q:\n%s", s, out)
+				}
+			}
+			if !strings.HasSuffix(out, "END:VCALENDAR\r\n") {
+				t.Errorf("calendar doesn't end with END:VCALENDAR")
+			}
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Jellyfin and Emby share the same REST API (Jellyfin is a fork of Emby),
+// so one client serves both. Authentication uses the X-Emby-Token header,
+// which both servers accept.
+
+// jellyfinItem is the subset of a Jellyfin/Emby BaseItemDto we need
+type jellyfinItem struct {
+	ID             string            `json:"Id"`
+	Name           string            `json:"Name"`
+	Type           string            `json:"Type"`
+	ProductionYear int               `json:"ProductionYear"`
+	DateCreated    string            `json:"DateCreated"`
+	Overview       string            `json:"Overview"`
+	ProviderIds    map[string]string `json:"ProviderIds"`
+	SeriesID       string            `json:"SeriesId"`
+	SeriesName     string            `json:"SeriesName"`
+}
+
+// jellyfinConfigured reports whether a Jellyfin/Emby server is configured
+func jellyfinConfigured(cfg *Config) bool {
+	return cfg.JellyfinURL != "" && cfg.JellyfinAPIKey != ""
+}
+
+// jellyfinServerName returns the display name for the configured server type
+func jellyfinServerName(serverType string) string {
+	if strings.EqualFold(serverType, "emby") {
+		return "Emby"
+	}
+	return "Jellyfin"
+}
+
+// newJellyfinRequest builds an authenticated request against a Jellyfin/Emby server
+func newJellyfinRequest(ctx context.Context, method, baseURL, apiKey, path string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(baseURL, "/")+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Emby-Token", apiKey)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
+
+// fetchJellyfinItems runs an /Items query and returns the decoded items
+func fetchJellyfinItems(ctx context.Context, cfg *Config, query url.Values) ([]jellyfinItem, error) {
+	req, err := newJellyfinRequest(ctx, "GET", cfg.JellyfinURL, cfg.JellyfinAPIKey, "/Items?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Items []jellyfinItem `json:"Items"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return result.Items, nil
+}
+
+// parseJellyfinTime parses the timestamps returned by Jellyfin/Emby
+// (RFC3339 with 7 fractional digits, occasionally without a zone)
+func parseJellyfinTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02T15:04:05.9999999", value)
+}
+
+// fetchJellyfinRecentlyAdded returns the movies and series that were added to the server since the given time.
+// New episodes are grouped under their series so a season drop shows up as one entry.
+func fetchJellyfinRecentlyAdded(ctx context.Context, cfg *Config, since time.Time) ([]MediaServerItem, error) {
+	if !jellyfinConfigured(cfg) {
+		return nil, nil
+	}
+
+	// Check cache first
+	cacheKey := getCacheKey("jellyfin_recently_added", cfg.JellyfinURL, since.Unix())
+	if cached, found := apiCache.Get(cacheKey); found {
+		log.Printf("📦 Using cached %s recently added", jellyfinServerName(cfg.JellyfinServerType))
+		return cached.([]MediaServerItem), nil
+	}
+
+	query := url.Values{}
+	query.Set("Recursive", "true")
+	query.Set("IncludeItemTypes", "Movie,Episode")
+	query.Set("SortBy", "DateCreated")
+	query.Set("SortOrder", "Descending")
+	query.Set("Fields", "DateCreated,Overview,ProviderIds")
+	query.Set("Limit", strconv.Itoa(cfg.APIPageSize))
+
+	items, err := fetchJellyfinItems(ctx, cfg, query)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := cfg.ServerRecentlyAddedLimit
+	if limit <= 0 {
+		limit = DefaultServerRecentlyAddedLimit
+	}
+
+	// Items are sorted newest first, so the first occurrence of a series is its latest addition
+	result := []MediaServerItem{}
+	seriesIndex := make(map[string]int)
+	seriesIDs := []string{}
+	for _, item := range items {
+		created, err := parseJellyfinTime(item.DateCreated)
+		if err == nil && created.Before(since) {
+			break
+		}
+
+		switch item.Type {
+		case "Movie":
+			if len(result) >= limit {
+				continue
+			}
+			result = append(result, MediaServerItem{
+				Title:    item.Name,
+				Type:     "Movie",
+				Year:     item.ProductionYear,
+				Overview: item.Overview,
+				IMDBID:   item.ProviderIds["Imdb"],
+			})
+		case "Episode":
+			key := item.SeriesID
+			if key == "" {
+				key = item.SeriesName
+			}
+			if idx, exists := seriesIndex[key]; exists {
+				result[idx].Episodes++
+				continue
+			}
+			if len(result) >= limit {
+				continue
+			}
+			seriesIndex[key] = len(result)
+			if item.SeriesID != "" {
+				seriesIDs = append(seriesIDs, item.SeriesID)
+			}
+			result = append(result, MediaServerItem{
+				Title:    item.SeriesName,
+				Type:     "Series",
+				Episodes: 1,
+			})
+		}
+	}
+
+	// Episodes don't carry series details, so look them up in one batch (non-fatal)
+	if len(seriesIDs) > 0 {
+		query := url.Values{}
+		query.Set("Ids", strings.Join(seriesIDs, ","))
+		query.Set("Fields", "Overview,ProviderIds")
+		series, err := fetchJellyfinItems(ctx, cfg, query)
+		if err != nil {
+			log.Printf("⚠️  Failed to fetch series details from %s: %v", jellyfinServerName(cfg.JellyfinServerType), err)
+		}
+		for _, s := range series {
+			if idx, exists := seriesIndex[s.ID]; exists {
+				result[idx].Year = s.ProductionYear
+				result[idx].Overview = s.Overview
+				result[idx].IMDBID = s.ProviderIds["Imdb"]
+			}
+		}
+	}
+
+	// Store in cache
+	apiCache.Set(cacheKey, result, cacheTTL)
+	return result, nil
+}
+
+// fetchJellyfinMostWatched returns the most played movies and series between start and end.
+// Play history is only recorded by the Playback Reporting plugin, which exposes a SQL query endpoint.
+func fetchJellyfinMostWatched(ctx context.Context, cfg *Config, start, end time.Time) ([]MediaServerItem, error) {
+	if !jellyfinConfigured(cfg) {
+		return nil, nil
+	}
+
+	serverName := jellyfinServerName(cfg.JellyfinServerType)
+
+	// Check cache first
+	cacheKey := getCacheKey("jellyfin_most_watched", cfg.JellyfinURL, start.Unix(), end.Unix())
+	if cached, found := apiCache.Get(cacheKey); found {
+		log.Printf("📦 Using cached %s most watched", serverName)
+		return cached.([]MediaServerItem), nil
+	}
+
+	// The plugin stores DateCreated as server-local time in "yyyy-MM-dd HH:mm:ss" format,
+	// so plain string comparison works for the date range. The server zone isn't exposed by the API;
+	// the bounds assume UTC, the default for containerized Jellyfin/Emby, not TIMEZONE.
+	query := fmt.Sprintf("SELECT ItemType, ItemName, COUNT(*) AS Plays FROM PlaybackActivity "+
+		"WHERE DateCreated >= '%s' AND DateCreated < '%s' AND ItemType IN ('Movie', 'Episode') "+
+		"GROUP BY ItemType, ItemName",
+		start.UTC().Format("2006-01-02 15:04:05"), end.UTC().Format("2006-01-02 15:04:05"))
+
+	body, err := json.Marshal(map[string]interface{}{
+		"CustomQueryString": query,
+		"ReplaceUserId":     false,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := newJellyfinRequest(ctx, "POST", cfg.JellyfinURL, cfg.JellyfinAPIKey, "/user_usage_stats/submit_custom_query", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("Playback Reporting plugin is not installed on %s", serverName)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+	}
+
+	// Note: "colums" is the plugin's own spelling
+	var result struct {
+		Columns []string   `json:"colums"`
+		Results [][]string `json:"results"`
+		Message string     `json:"message"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	if result.Message != "" && len(result.Results) == 0 {
+		return nil, fmt.Errorf("playback query failed: %s", result.Message)
+	}
+
+	// Episodes are reported as "Series - s01e02 - Episode", so fold them into their series
+	plays := make(map[string]*MediaServerItem)
+	for _, row := range result.Results {
+		if len(row) < 3 {
+			continue
+		}
+		itemType, name := row[0], row[1]
+		count, err := strconv.Atoi(row[2])
+		if err != nil {
+			continue
+		}
+
+		if itemType == "Episode" {
+			itemType = "Series"
+			name = strings.SplitN(name, " - ", 2)[0]
+		}
+
+		key := itemType + ":" + name
+		if item, exists := plays[key]; exists {
+			item.Plays += count
+		} else {
+			plays[key] = &MediaServerItem{Title: name, Type: itemType, Plays: count}
+		}
+	}
+
+	items := make([]MediaServerItem, 0, len(plays))
+	for _, item := range plays {
+		items = append(items, *item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Plays != items[j].Plays {
+			return items[i].Plays > items[j].Plays
+		}
+		return items[i].Title < items[j].Title
+	})
+
+	limit := cfg.ServerMostWatchedLimit
+	if limit <= 0 {
+		limit = DefaultServerMostWatchedLimit
+	}
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	// Store in cache
+	apiCache.Set(cacheKey, items, cacheTTL)
+	return items, nil
+}
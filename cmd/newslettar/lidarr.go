@@ -0,0 +1,263 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+)
+
+// Lidarr uses the same *arr API conventions as Sonarr/Radarr, but on /api/v1
+
+// lidarrAlbum is the subset of a Lidarr AlbumResource we need
+type lidarrAlbum struct {
+	ID             int    `json:"id"`
+	Title          string `json:"title"`
+	ForeignAlbumID string `json:"foreignAlbumId"` // MusicBrainz release group ID
+	AlbumType      string `json:"albumType"`
+	ReleaseDate    string `json:"releaseDate"`
+	Overview       string `json:"overview"`
+	Monitored      bool   `json:"monitored"`
+	Images         []struct {
+		CoverType string `json:"coverType"`
+		URL       string `json:"url"`
+		RemoteURL string `json:"remoteUrl"`
+	} `json:"images"`
+	Releases []struct {
+		TrackCount int  `json:"trackCount"`
+		Monitored  bool `json:"monitored"`
+	} `json:"releases"`
+	Statistics *struct {
+		TotalTrackCount int `json:"totalTrackCount"`
+	} `json:"statistics"`
+	Artist *lidarrArtist `json:"artist"`
+}
+
+type lidarrArtist struct {
+	ArtistName string `json:"artistName"`
+}
+
+// lidarrConfigured reports whether a Lidarr server is configured
+func lidarrConfigured(cfg *Config) bool {
+	return cfg.LidarrURL != "" && cfg.LidarrAPIKey != ""
+}
+
+// toAlbum maps a Lidarr album to the template Album struct
+func (a lidarrAlbum) toAlbum(artist *lidarrArtist) Album {
+	coverURL := ""
+	for _, img := range a.Images {
+		if img.CoverType == "cover" {
+			if img.RemoteURL != "" {
+				coverURL = img.RemoteURL
+			} else {
+				coverURL = img.URL
+			}
+			break
+		}
+	}
+
+	// Statistics are only populated on some endpoints; fall back to the monitored release
+	trackCount := 0
+	if a.Statistics != nil {
+		trackCount = a.Statistics.TotalTrackCount
+	}
+	if trackCount == 0 {
+		for _, release := range a.Releases {
+			if release.Monitored {
+				trackCount = release.TrackCount
+				break
+			}
+		}
+	}
+
+	if artist == nil {
+		artist = a.Artist
+	}
+	artistName := ""
+	if artist != nil {
+		artistName = artist.ArtistName
+	}
+
+	releaseDate := ""
+	if t, err := time.Parse(time.RFC3339, a.ReleaseDate); err == nil {
+		releaseDate = t.Format("2006-01-02")
+	}
+
+	return Album{
+		Title:         a.Title,
+		Artist:        artistName,
+		AlbumType:     a.AlbumType,
+		ReleaseDate:   releaseDate,
+		CoverURL:      coverURL,
+		TrackCount:    trackCount,
+		Overview:      a.Overview,
+		MusicBrainzID: a.ForeignAlbumID,
+		Monitored:     a.Monitored,
+	}
+}
+
+// Retry wrappers for Lidarr API calls
+func fetchLidarrHistoryWithRetry(ctx context.Context, cfg *Config, since time.Time, maxRetries int) ([]Album, error) {
+	return retryWithBackoff(func() ([]Album, error) {
+		return fetchLidarrHistory(ctx, cfg, since)
+	}, "Lidarr history", maxRetries)
+}
+
+func fetchLidarrCalendarWithRetry(ctx context.Context, cfg *Config, start, end time.Time, maxRetries int) ([]Album, error) {
+	return retryWithBackoff(func() ([]Album, error) {
+		return fetchLidarrCalendar(ctx, cfg, start, end)
+	}, "Lidarr calendar", maxRetries)
+}
+
+// fetchLidarrHistory returns the albums imported since the given time.
+// Lidarr records one import event per track, so albums are deduplicated by ID.
+func fetchLidarrHistory(ctx context.Context, cfg *Config, since time.Time) ([]Album, error) {
+	if !lidarrConfigured(cfg) {
+		return nil, fmt.Errorf("Lidarr not configured")
+	}
+
+	// Check cache first
+	cacheKey := getCacheKey("lidarr_history", cfg.LidarrURL, since.Unix())
+	if cached, found := apiCache.Get(cacheKey); found {
+		log.Printf("📦 Using cached Lidarr history")
+		return cached.([]Album), nil
+	}
+
+	albums := []Album{}
+	seen := make(map[int]bool)
+	page := 1
+
+	for {
+		url := fmt.Sprintf("%s/api/v1/history?page=%d&pageSize=%d&sortKey=date&sortDirection=descending&includeAlbum=true&includeArtist=true", cfg.LidarrURL, page, cfg.APIPageSize)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("X-Api-Key", cfg.LidarrAPIKey)
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != 200 {
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, fmt.Errorf("HTTP %d (failed to read error body: %v)", resp.StatusCode, err)
+			}
+			return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+		}
+
+		var result struct {
+			Page         int `json:"page"`
+			PageSize     int `json:"pageSize"`
+			TotalRecords int `json:"totalRecords"`
+			Records      []struct {
+				AlbumID   int           `json:"albumId"`
+				Date      time.Time     `json:"date"`
+				EventType string        `json:"eventType"`
+				Album     lidarrAlbum   `json:"album"`
+				Artist    *lidarrArtist `json:"artist"`
+			} `json:"records"`
+		}
+
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		resp.Body.Close()
+
+		// Track if we found any records older than our date range
+		foundOldRecords := false
+
+		for _, record := range result.Records {
+			// Only include import events
+			if record.EventType != "trackFileImported" && record.EventType != "downloadImported" {
+				continue
+			}
+
+			// Filter by date - if record is before our range, mark it but continue
+			if record.Date.Before(since) {
+				foundOldRecords = true
+				continue
+			}
+
+			if seen[record.AlbumID] {
+				continue
+			}
+			seen[record.AlbumID] = true
+
+			album := record.Album.toAlbum(record.Artist)
+			album.Downloaded = true
+			albums = append(albums, album)
+		}
+
+		// Stop pagination if:
+		// 1. We've fetched all records, OR
+		// 2. We found records older than our date range (no need to fetch older pages)
+		if len(result.Records) == 0 || page*result.PageSize >= result.TotalRecords || foundOldRecords {
+			break
+		}
+
+		page++
+		log.Printf("📄 Fetching Lidarr history page %d...", page)
+	}
+
+	// Store in cache
+	apiCache.Set(cacheKey, albums, cacheTTL)
+
+	return albums, nil
+}
+
+// fetchLidarrCalendar returns the albums releasing between start and end
+func fetchLidarrCalendar(ctx context.Context, cfg *Config, start, end time.Time) ([]Album, error) {
+	if !lidarrConfigured(cfg) {
+		return nil, fmt.Errorf("Lidarr not configured")
+	}
+
+	// Check cache first
+	cacheKey := getCacheKey("lidarr_calendar", cfg.LidarrURL, start.Unix(), end.Unix())
+	if cached, found := apiCache.Get(cacheKey); found {
+		log.Printf("📦 Using cached Lidarr calendar")
+		return cached.([]Album), nil
+	}
+
+	url := fmt.Sprintf("%s/api/v1/calendar?unmonitored=true&includeArtist=true&start=%s&end=%s",
+		cfg.LidarrURL, start.Format("2006-01-02"), end.Format("2006-01-02"))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Api-Key", cfg.LidarrAPIKey)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+	}
+
+	// Stream-decode JSON to save memory
+	var calendar []lidarrAlbum
+	if err := json.NewDecoder(resp.Body).Decode(&calendar); err != nil {
+		return nil, err
+	}
+
+	albums := make([]Album, 0, len(calendar))
+	for _, entry := range calendar {
+		albums = append(albums, entry.toAlbum(nil))
+	}
+
+	// Store in cache
+	apiCache.Set(cacheKey, albums, cacheTTL)
+
+	return albums, nil
+}
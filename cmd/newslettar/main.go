@@ -6,13 +6,14 @@ import (
 	"html/template"
 	"log"
 	"net/http"
+	texttemplate "text/template"
 	"time"
 	_ "time/tzdata" // Embed timezone database for Windows support
 )
 
 // Embed static files to reduce memory and simplify deployment
 //
-//go:embed templates/*.html
+//go:embed templates/*.html templates/*.txt
 var templateFS embed.FS
 
 //go:embed assets/*
@@ -35,6 +36,8 @@ var httpClient = &http.Client{
 
 // Precompiled templates (compiled once at startup)
 var emailTemplate *template.Template
+var emailTextTemplate *texttemplate.Template
+var adminTemplate *template.Template
 
 // Global statistics tracker
 var stats = &Statistics{}
@@ -76,6 +79,14 @@ func main() {
 	if err != nil {
 		log.Fatalf("❌ Failed to parse email template: %v", err)
 	}
+	emailTextTemplate, err = initEmailTextTemplate()
+	if err != nil {
+		log.Fatalf("❌ Failed to parse plain-text email template: %v", err)
+	}
+	adminTemplate, err = initAdminTemplate()
+	if err != nil {
+		log.Fatalf("❌ Failed to parse admin digest template: %v", err)
+	}
 
 	if *webMode {
 		startWebServer()
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Sonarr and Radarr (v5+) expose monitored items without a file on /api/v3/wanted/missing.
+// Older Radarr versions don't have that endpoint, so Radarr falls back to filtering the movie list.
+
+// sonarrMissingRecord is the subset of a Sonarr wanted/missing EpisodeResource we need
+type sonarrMissingRecord struct {
+	SeasonNumber  int    `json:"seasonNumber"`
+	EpisodeNumber int    `json:"episodeNumber"`
+	Title         string `json:"title"`
+	AirDateUtc    string `json:"airDateUtc"`
+	Series        *struct {
+		Title  string     `json:"title"`
+		Images []arrImage `json:"images"`
+	} `json:"series"`
+}
+
+// radarrMissingRecord is the subset of a Radarr MovieResource we need
+type radarrMissingRecord struct {
+	Title           string     `json:"title"`
+	Year            int        `json:"year"`
+	Monitored       bool       `json:"monitored"`
+	HasFile         bool       `json:"hasFile"`
+	IsAvailable     bool       `json:"isAvailable"`
+	InCinemas       string     `json:"inCinemas"`
+	DigitalRelease  string     `json:"digitalRelease"`
+	PhysicalRelease string     `json:"physicalRelease"`
+	Images          []arrImage `json:"images"`
+}
+
+// releaseDate returns the earliest home release date, falling back to the cinema release
+func (m radarrMissingRecord) releaseDate() string {
+	for _, date := range []string{m.DigitalRelease, m.PhysicalRelease, m.InCinemas} {
+		if t, err := time.Parse(time.RFC3339, date); err == nil {
+			return t.Format("2006-01-02")
+		}
+	}
+	return ""
+}
+
+func fetchSonarrMissingWithRetry(ctx context.Context, cfg *Config, inst ArrInstance, maxRetries int) ([]MissingItem, error) {
+	return retryWithBackoff(func() ([]MissingItem, error) {
+		return fetchSonarrMissing(ctx, cfg, inst)
+	}, inst.Name+" missing", maxRetries)
+}
+
+func fetchRadarrMissingWithRetry(ctx context.Context, cfg *Config, inst ArrInstance, maxRetries int) ([]MissingItem, error) {
+	return retryWithBackoff(func() ([]MissingItem, error) {
+		return fetchRadarrMissing(ctx, cfg, inst)
+	}, inst.Name+" missing", maxRetries)
+}
+
+// arrGet performs an authenticated GET against a Sonarr/Radarr instance and decodes the JSON response into v.
+// It returns the HTTP status code so callers can detect missing endpoints.
+func arrGet(ctx context.Context, inst ArrInstance, url string, v interface{}) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("X-Api-Key", inst.APIKey)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, json.NewDecoder(resp.Body).Decode(v)
+}
+
+// fetchSonarrMissing returns the monitored episodes that have aired but have no file
+func fetchSonarrMissing(ctx context.Context, cfg *Config, inst ArrInstance) ([]MissingItem, error) {
+	if !inst.configured() {
+		return nil, fmt.Errorf("%s not configured", inst.Name)
+	}
+
+	// Check cache first
+	cacheKey := getCacheKey("sonarr_missing", inst.URL)
+	if cached, found := apiCache.Get(cacheKey); found {
+		log.Printf("📦 Using cached %s missing episodes", inst.Name)
+		return cached.([]MissingItem), nil
+	}
+
+	url := fmt.Sprintf("%s/api/v3/wanted/missing?page=1&pageSize=%d&sortKey=airDateUtc&sortDirection=descending&monitored=true&includeSeries=true",
+		inst.URL, cfg.APIPageSize)
+	var result struct {
+		Records []sonarrMissingRecord `json:"records"`
+	}
+	if _, err := arrGet(ctx, inst, url, &result); err != nil {
+		return nil, err
+	}
+
+	items := make([]MissingItem, 0, len(result.Records))
+	for _, record := range result.Records {
+		if record.Series == nil {
+			continue
+		}
+		item := MissingItem{
+			Title:     record.Series.Title,
+			Subtitle:  fmt.Sprintf("S%02dE%02d", record.SeasonNumber, record.EpisodeNumber),
+			Type:      "Episode",
+			PosterURL: posterURL(record.Series.Images),
+			Instances: []string{inst.Name},
+		}
+		if record.Title != "" {
+			item.Subtitle += " • " + record.Title
+		}
+		if t, err := time.Parse(time.RFC3339, record.AirDateUtc); err == nil {
+			item.ReleaseDate = t.Format("2006-01-02")
+		}
+		items = append(items, item)
+	}
+
+	// Store in cache
+	apiCache.Set(cacheKey, items, cacheTTL)
+
+	return items, nil
+}
+
+// fetchRadarrMissing returns the monitored, released movies that have no file
+func fetchRadarrMissing(ctx context.Context, cfg *Config, inst ArrInstance) ([]MissingItem, error) {
+	if !inst.configured() {
+		return nil, fmt.Errorf("%s not configured", inst.Name)
+	}
+
+	// Check cache first
+	cacheKey := getCacheKey("radarr_missing", inst.URL)
+	if cached, found := apiCache.Get(cacheKey); found {
+		log.Printf("📦 Using cached %s missing movies", inst.Name)
+		return cached.([]MissingItem), nil
+	}
+
+	url := fmt.Sprintf("%s/api/v3/wanted/missing?page=1&pageSize=%d&sortKey=movies.sortTitle&sortDirection=ascending&monitored=true",
+		inst.URL, cfg.APIPageSize)
+	var result struct {
+		Records []radarrMissingRecord `json:"records"`
+	}
+	status, err := arrGet(ctx, inst, url, &result)
+	if status == http.StatusNotFound {
+		// Radarr v4 and older: filter the full movie list instead
+		log.Printf("ℹ️  %s has no wanted/missing endpoint, filtering the movie list", inst.Name)
+		result.Records = nil
+		_, err = arrGet(ctx, inst, inst.URL+"/api/v3/movie", &result.Records)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	items := []MissingItem{}
+	for _, record := range result.Records {
+		if !record.Monitored || record.HasFile || !record.IsAvailable {
+			continue
+		}
+		items = append(items, MissingItem{
+			Title:       record.Title,
+			Type:        "Movie",
+			Year:        record.Year,
+			ReleaseDate: record.releaseDate(),
+			PosterURL:   posterURL(record.Images),
+			Instances:   []string{inst.Name},
+		})
+	}
+
+	// Store in cache
+	apiCache.Set(cacheKey, items, cacheTTL)
+
+	return items, nil
+}
+
+// mergeMissingItems removes duplicates reported by several instances, sorts the result
+// with the most recently released items first, and caps it at limit.
+// It also returns the total number of missing items before the cap.
+func mergeMissingItems(items []MissingItem, limit int) ([]MissingItem, int) {
+	seen := make(map[string]int)
+	merged := []MissingItem{}
+	for _, item := range items {
+		key := strings.ToLower(item.Type + "|" + item.Title + "|" + item.Subtitle)
+		if i, ok := seen[key]; ok {
+			merged[i].Instances = mergeInstanceNames(merged[i].Instances, item.Instances)
+			continue
+		}
+		seen[key] = len(merged)
+		merged = append(merged, item)
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].ReleaseDate > merged[j].ReleaseDate
+	})
+	total := len(merged)
+	if len(merged) > limit {
+		merged = merged[:limit]
+	}
+	return merged, total
+}
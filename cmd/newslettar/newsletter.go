@@ -3,17 +3,60 @@ package main
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
 	"fmt"
 	"html/template"
 	"log"
-	"net/smtp"
 	"sort"
 	"strings"
 	"sync"
 	"time"
 )
 
+// newsletterPeriod holds the date windows covered by one newsletter issue
+type newsletterPeriod struct {
+	Start       time.Time // Start of the historical (downloaded) window
+	End         time.Time // End of the historical window and start of the upcoming window
+	UpcomingEnd time.Time // End of the upcoming window
+	Monthly     bool      // Monthly schedule (month-long windows)
+}
+
+// calculateNewsletterPeriod returns the newsletter windows for the configured schedule type
+func calculateNewsletterPeriod(cfg *Config, now time.Time) newsletterPeriod {
+	var period newsletterPeriod
+	if cfg.ScheduleType == "monthly" {
+		// Monthly: previous month and current month
+		period.Monthly = true
+		period.Start = time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, now.Location())
+		period.End = now
+		period.UpcomingEnd = now.AddDate(0, 1, 0) // Next month
+	} else {
+		// Weekly: last 7 days
+		period.Start = now.AddDate(0, 0, -7)
+		period.End = now
+		period.UpcomingEnd = now.AddDate(0, 0, 7) // Next 7 days
+	}
+	return period
+}
+
+// traktPeriod returns the Trakt /watched period matching the schedule ("weekly" or "monthly")
+func (p newsletterPeriod) traktPeriod() string {
+	if p.Monthly {
+		return "monthly"
+	}
+	return "weekly"
+}
+
+// upcoming reports whether t falls on a day of the upcoming window (End through UpcomingEnd)
+func (p newsletterPeriod) upcoming(t time.Time) bool {
+	day := t.Format("2006-01-02")
+	return day >= p.End.Format("2006-01-02") && day <= p.UpcomingEnd.Format("2006-01-02")
+}
+
+// cacheKey identifies the period for caching: stable for a day, different for weekly and monthly
+func (p newsletterPeriod) cacheKey() string {
+	return p.traktPeriod() + ":" + p.End.Format("2006-01-02")
+}
+
 // Newsletter sending logic with parallel API calls
 func runNewsletter() {
 	cfg := getConfig()
@@ -28,133 +71,322 @@ func runNewsletter() {
 	log.Printf("⏰ Current time: %s (%s)", now.Format("2006-01-02 15:04:05"), cfg.Timezone)
 
 	// Calculate timeframe based on schedule type
-	var weekStart, weekEnd time.Time
-	if cfg.ScheduleType == "monthly" {
-		// Monthly: previous month and current month
-		weekStart = time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, loc)
-		weekEnd = now
-	} else {
-		// Weekly: last 7 days
-		weekStart = now.AddDate(0, 0, -7)
-		weekEnd = now
-	}
+	period := calculateNewsletterPeriod(cfg, now)
 
 	rangeLabel := "Week"
 	if cfg.ScheduleType == "monthly" {
 		rangeLabel = "Month"
 	}
-	log.Printf("📅 %s range: %s to %s", rangeLabel, weekStart.Format("2006-01-02"), weekEnd.Format("2006-01-02"))
+	log.Printf("📅 %s range: %s to %s", rangeLabel, period.Start.Format("2006-01-02"), period.End.Format("2006-01-02"))
+
+	// Use a cancellable context for all fetches
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.APITimeout)*time.Second)
+	defer cancel()
+
+	data, err := buildNewsletterData(ctx, cfg, period, cfg.MaxRetries)
+	if err != nil {
+		log.Printf("❌ %v - cannot generate newsletter", err)
+		return
+	}
 
-	// Calculate upcoming period based on schedule type
-	var upcomingEnd time.Time
+	// Check if we have any content to send
+	hasContent := len(data.UpcomingSeriesGroups) > 0 || len(data.UpcomingMovies) > 0 ||
+		(cfg.ShowDownloaded && (len(data.DownloadedSeriesGroups) > 0 || len(data.DownloadedMovies) > 0)) ||
+		len(data.ServerMostWatched) > 0 || len(data.ServerRecentlyAdded) > 0 ||
+		len(data.UpcomingAlbums) > 0 || (cfg.ShowDownloaded && len(data.DownloadedAlbums) > 0) ||
+		len(data.UpcomingBooks) > 0 || (cfg.ShowDownloaded && len(data.DownloadedBooks) > 0) ||
+		len(data.PendingRequests) > 0 ||
+		(data.ServerStats != nil && (len(data.ServerStats.TopMovies) > 0 || len(data.ServerStats.TopShows) > 0 || len(data.ServerStats.TopPlatforms) > 0)) ||
+		len(data.QueueItems) > 0 ||
+		len(data.MissingItems) > 0 ||
+		len(data.TraktWatchlistShows) > 0 || len(data.TraktWatchlistMovies) > 0 ||
+		len(data.TraktRecommendedShows) > 0 || len(data.TraktRecommendedMovies) > 0 ||
+		len(data.TraktCalendarShows) > 0 || len(data.TraktCalendarMovies) > 0 ||
+		len(data.TraktListSections) > 0
+
+	if !hasContent {
+		log.Println("ℹ️  No new content to report. Skipping newsletter.")
+		return
+	}
+
+	log.Println("📝 Generating newsletter HTML...")
+	html, err := generateNewsletterHTML(data, cfg)
+	if err != nil {
+		log.Fatalf("❌ Failed to generate HTML: %v", err)
+	}
+
+	// The plain-text part is optional: mail clients fall back to the HTML without it
+	text, err := generateNewsletterText(data)
+	if err != nil {
+		log.Printf("⚠️  Failed to generate plain-text newsletter: %v", err)
+		text = ""
+	}
+
+	// Generate subject line based on schedule type
+	var subject string
 	if cfg.ScheduleType == "monthly" {
-		upcomingEnd = weekEnd.AddDate(0, 1, 0) // Next month
+		subject = fmt.Sprintf("📺 Your Monthly Newsletter - %s", period.End.Format("January 2006"))
 	} else {
-		upcomingEnd = weekEnd.AddDate(0, 0, 7) // Next 7 days
+		subject = fmt.Sprintf("📺 Your Weekly Newsletter - %s", period.End.Format("January 2, 2006"))
 	}
 
-	// Use a cancellable context for all fetches
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.APITimeout)*time.Second)
-	defer cancel()
+	// Deliver through every configured notifier (email, Discord, ...) with a fresh deadline,
+	// the fetch context may be nearly used up
+	sendCtx, cancelSend := context.WithTimeout(context.Background(), DefaultNotifyTimeout)
+	defer cancelSend()
+
+	issue := &NewsletterIssue{Subject: subject, HTML: html, Text: text, Data: data, Period: period}
+
+	// Push notifications link to the web archive copy, which is only written once the issue
+	// went out somewhere, so the archive and feeds never list an issue nobody received
+	issue.ArchiveURL = archiveURL(cfg, archiveID(issue))
+	delivered, err := deliverNewsletter(sendCtx, configuredNotifiers(cfg), issue)
+	if len(delivered) == 0 {
+		log.Fatalf("❌ Failed to send newsletter: %v", err)
+	}
+	if err != nil {
+		log.Printf("⚠️  Some deliveries failed: %v", err)
+	}
+	if err := saveArchive(issue); err != nil {
+		log.Printf("⚠️  Failed to archive newsletter: %v", err)
+	}
+
+	// Update statistics after successful send
+	stats.mu.Lock()
+	if delivered["email"] {
+		stats.TotalEmailsSent += len(cfg.ToEmails)
+	}
+	stats.LastSentDate = now
+	stats.LastSentDateStr = now.Format("2006-01-02 15:04:05 MST")
+	stats.mu.Unlock()
+
+	// Persist statistics to disk
+	if err := saveStats(); err != nil {
+		log.Printf("⚠️  Failed to save statistics: %v", err)
+	}
+
+	log.Println("✅ Newsletter sent successfully!")
+
+	// Clear data to free memory immediately
+	data = NewsletterData{}
+}
+
+// buildNewsletterData fetches all configured sources in parallel and assembles the template data.
+// Shared by the scheduled run and the preview handler. Returns an error only when every
+// configured Sonarr/Radarr/Lidarr/Readarr instance failed; partial failures degrade gracefully.
+func buildNewsletterData(ctx context.Context, cfg *Config, period newsletterPeriod, retries int) (NewsletterData, error) {
+	weekStart, weekEnd, upcomingEnd := period.Start, period.End, period.UpcomingEnd
+
+	sonarrInstances := configuredInstances(cfg.SonarrInstances)
+	radarrInstances := configuredInstances(cfg.RadarrInstances)
+	var readarrInstances []ArrInstance
+	if cfg.ShowBooks {
+		readarrInstances = configuredInstances(cfg.ReadarrInstances)
+	}
 
 	// Parallel API calls (3-4x faster!)
+	// Each goroutine writes only to its own slot, so no locking is needed
 	var wg sync.WaitGroup
-	var downloadedEpisodes, upcomingEpisodes []Episode
-	var downloadedMovies, upcomingMovies []Movie
+	sonarrHistory := make([][]Episode, len(sonarrInstances))
+	sonarrCalendar := make([][]Episode, len(sonarrInstances))
+	sonarrHistoryErrs := make([]error, len(sonarrInstances))
+	sonarrCalendarErrs := make([]error, len(sonarrInstances))
+	radarrHistory := make([][]Movie, len(radarrInstances))
+	radarrCalendar := make([][]Movie, len(radarrInstances))
+	radarrHistoryErrs := make([]error, len(radarrInstances))
+	radarrCalendarErrs := make([]error, len(radarrInstances))
+	readarrHistory := make([][]Book, len(readarrInstances))
+	readarrCalendar := make([][]Book, len(readarrInstances))
+	readarrHistoryErrs := make([]error, len(readarrInstances))
+	readarrCalendarErrs := make([]error, len(readarrInstances))
 	var traktAnticipatedSeries, traktWatchedSeries []TraktShow
 	var traktAnticipatedMovies, traktWatchedMovies []TraktMovie
-	var errSonarrHistory, errSonarrCalendar, errRadarrHistory, errRadarrCalendar error
+	var traktWatchlistShows, traktRecommendedShows, traktCalendarShows []TraktShow
+	var traktWatchlistMovies, traktRecommendedMovies, traktCalendarMovies []TraktMovie
+	traktListSections := make([]TraktListSection, len(cfg.TraktLists))
+	var serverMostWatched, serverRecentlyAdded []MediaServerItem
+	var plexLibrary *PlexLibrary
+	var plexRecentlyAdded []MediaServerItem
+	var downloadedAlbums, upcomingAlbums []Album
+	var lidarrHistoryErr, lidarrCalendarErr error
+	var overseerrRequests *OverseerrRequests
+	var pendingRequests []PendingRequest
+	var serverStats *ServerStats
+	sonarrQueue := make([][]QueueItem, len(sonarrInstances))
+	radarrQueue := make([][]QueueItem, len(radarrInstances))
+	sonarrMissing := make([][]MissingItem, len(sonarrInstances))
+	radarrMissing := make([][]MissingItem, len(radarrInstances))
 
 	log.Println("📡 Fetching data in parallel...")
 	startFetch := time.Now()
 
-	// Check which services are configured
-	hasSonarr := cfg.SonarrURL != "" && cfg.SonarrAPIKey != ""
-	hasRadarr := cfg.RadarrURL != "" && cfg.RadarrAPIKey != ""
-
-	// Count API calls (only for configured services + Trakt if enabled)
-	apiCalls := 0
-	if hasSonarr {
-		apiCalls += 2 // history + calendar
-	}
-	if hasRadarr {
-		apiCalls += 2 // history + calendar
-	}
-	if cfg.ShowTraktAnticipatedSeries {
-		apiCalls++
-	}
-	if cfg.ShowTraktWatchedSeries {
-		apiCalls++
-	}
-	if cfg.ShowTraktAnticipatedMovies {
-		apiCalls++
-	}
-	if cfg.ShowTraktWatchedMovies {
-		apiCalls++
+	// Only fetch from Sonarr instances that are configured
+	if len(sonarrInstances) == 0 {
+		log.Println("📺 Sonarr not configured, skipping...")
 	}
+	for i, inst := range sonarrInstances {
+		wg.Add(2) // history + calendar
+		go func(i int, inst ArrInstance) {
+			defer wg.Done()
+			log.Printf("📺 Fetching %s history...", inst.Name)
+			episodes, err := fetchSonarrHistoryWithRetry(ctx, cfg, inst, weekStart, retries)
+			if err != nil {
+				log.Printf("⚠️  %s history error: %v", inst.Name, err)
+				sonarrHistoryErrs[i] = err
+				return
+			}
+			sonarrHistory[i] = episodes
+			log.Printf("✓ Found %d downloaded episodes in %s", len(episodes), inst.Name)
+		}(i, inst)
 
-	wg.Add(apiCalls)
+		go func(i int, inst ArrInstance) {
+			defer wg.Done()
+			log.Printf("📺 Fetching %s calendar...", inst.Name)
+			episodes, err := fetchSonarrCalendarWithRetry(ctx, cfg, inst, weekEnd, upcomingEnd, retries)
+			if err != nil {
+				log.Printf("⚠️  %s calendar error: %v", inst.Name, err)
+				sonarrCalendarErrs[i] = err
+				return
+			}
+			sonarrCalendar[i] = episodes
+			log.Printf("✓ Found %d upcoming episodes in %s", len(episodes), inst.Name)
+		}(i, inst)
+	}
 
-	// Only fetch from Sonarr if configured
-	if hasSonarr {
-		go func() {
+	// Only fetch from Radarr instances that are configured
+	if len(radarrInstances) == 0 {
+		log.Println("🎬 Radarr not configured, skipping...")
+	}
+	for i, inst := range radarrInstances {
+		wg.Add(2) // history + calendar
+		go func(i int, inst ArrInstance) {
 			defer wg.Done()
-			log.Println("📺 Fetching Sonarr history...")
-			downloadedEpisodes, errSonarrHistory = fetchSonarrHistoryWithRetry(ctx, cfg, weekStart, cfg.MaxRetries)
-			if errSonarrHistory != nil {
-				log.Printf("⚠️  Sonarr history error: %v", errSonarrHistory)
-			} else {
-				log.Printf("✓ Found %d downloaded episodes", len(downloadedEpisodes))
+			log.Printf("🎬 Fetching %s history...", inst.Name)
+			movies, err := fetchRadarrHistoryWithRetry(ctx, cfg, inst, weekStart, retries)
+			if err != nil {
+				log.Printf("⚠️  %s history error: %v", inst.Name, err)
+				radarrHistoryErrs[i] = err
+				return
 			}
-		}()
+			radarrHistory[i] = movies
+			log.Printf("✓ Found %d downloaded movies in %s", len(movies), inst.Name)
+		}(i, inst)
 
-		go func() {
+		go func(i int, inst ArrInstance) {
 			defer wg.Done()
-			log.Println("📺 Fetching Sonarr calendar...")
-			upcomingEpisodes, errSonarrCalendar = fetchSonarrCalendarWithRetry(ctx, cfg, weekEnd, upcomingEnd, cfg.MaxRetries)
-			if errSonarrCalendar != nil {
-				log.Printf("⚠️  Sonarr calendar error: %v", errSonarrCalendar)
-			} else {
-				log.Printf("✓ Found %d upcoming episodes", len(upcomingEpisodes))
+			log.Printf("🎬 Fetching %s calendar...", inst.Name)
+			movies, err := fetchRadarrCalendarWithRetry(ctx, cfg, inst, weekEnd, upcomingEnd, retries)
+			if err != nil {
+				log.Printf("⚠️  %s calendar error: %v", inst.Name, err)
+				radarrCalendarErrs[i] = err
+				return
 			}
-		}()
-	} else {
-		log.Println("📺 Sonarr not configured, skipping...")
+			radarrCalendar[i] = movies
+			log.Printf("✓ Found %d upcoming movies in %s", len(movies), inst.Name)
+		}(i, inst)
 	}
 
-	// Only fetch from Radarr if configured
-	if hasRadarr {
-		go func() {
+	// Fetch the Sonarr/Radarr download queues (failures only hide the section)
+	if cfg.ShowQueue {
+		for i, inst := range sonarrInstances {
+			wg.Add(1)
+			go func(i int, inst ArrInstance) {
+				defer wg.Done()
+				log.Printf("⬇️  Fetching %s queue...", inst.Name)
+				items, err := fetchQueueWithRetry(ctx, cfg, inst, "includeSeries=true&includeEpisode=true", retries)
+				if err != nil {
+					log.Printf("⚠️  %s queue error: %v", inst.Name, err)
+					return
+				}
+				sonarrQueue[i] = items
+				log.Printf("✓ Found %d queued episodes in %s", len(items), inst.Name)
+			}(i, inst)
+		}
+		for i, inst := range radarrInstances {
+			wg.Add(1)
+			go func(i int, inst ArrInstance) {
+				defer wg.Done()
+				log.Printf("⬇️  Fetching %s queue...", inst.Name)
+				items, err := fetchQueueWithRetry(ctx, cfg, inst, "includeMovie=true", retries)
+				if err != nil {
+					log.Printf("⚠️  %s queue error: %v", inst.Name, err)
+					return
+				}
+				radarrQueue[i] = items
+				log.Printf("✓ Found %d queued movies in %s", len(items), inst.Name)
+			}(i, inst)
+		}
+	}
+
+	// Fetch missing episodes and movies (failures only hide the section)
+	if cfg.ShowMissing {
+		for i, inst := range sonarrInstances {
+			wg.Add(1)
+			go func(i int, inst ArrInstance) {
+				defer wg.Done()
+				log.Printf("🔎 Fetching %s missing episodes...", inst.Name)
+				items, err := fetchSonarrMissingWithRetry(ctx, cfg, inst, retries)
+				if err != nil {
+					log.Printf("⚠️  %s missing error: %v", inst.Name, err)
+					return
+				}
+				sonarrMissing[i] = items
+				log.Printf("✓ Found %d missing episodes in %s", len(items), inst.Name)
+			}(i, inst)
+		}
+		for i, inst := range radarrInstances {
+			wg.Add(1)
+			go func(i int, inst ArrInstance) {
+				defer wg.Done()
+				log.Printf("🔎 Fetching %s missing movies...", inst.Name)
+				items, err := fetchRadarrMissingWithRetry(ctx, cfg, inst, retries)
+				if err != nil {
+					log.Printf("⚠️  %s missing error: %v", inst.Name, err)
+					return
+				}
+				radarrMissing[i] = items
+				log.Printf("✓ Found %d missing movies in %s", len(items), inst.Name)
+			}(i, inst)
+		}
+	}
+
+	// Fetch Readarr books from every configured instance
+	for i, inst := range readarrInstances {
+		wg.Add(2) // history + calendar
+		go func(i int, inst ArrInstance) {
 			defer wg.Done()
-			log.Println("🎬 Fetching Radarr history...")
-			downloadedMovies, errRadarrHistory = fetchRadarrHistoryWithRetry(ctx, cfg, weekStart, cfg.MaxRetries)
-			if errRadarrHistory != nil {
-				log.Printf("⚠️  Radarr history error: %v", errRadarrHistory)
-			} else {
-				log.Printf("✓ Found %d downloaded movies", len(downloadedMovies))
+			log.Printf("📚 Fetching %s history...", inst.Name)
+			books, err := fetchReadarrHistoryWithRetry(ctx, cfg, inst, weekStart, retries)
+			if err != nil {
+				log.Printf("⚠️  %s history error: %v", inst.Name, err)
+				readarrHistoryErrs[i] = err
+				return
 			}
-		}()
+			readarrHistory[i] = books
+			log.Printf("✓ Found %d downloaded books in %s", len(books), inst.Name)
+		}(i, inst)
 
-		go func() {
+		go func(i int, inst ArrInstance) {
 			defer wg.Done()
-			log.Println("🎬 Fetching Radarr calendar...")
-			upcomingMovies, errRadarrCalendar = fetchRadarrCalendarWithRetry(ctx, cfg, weekEnd, upcomingEnd, cfg.MaxRetries)
-			if errRadarrCalendar != nil {
-				log.Printf("⚠️  Radarr calendar error: %v", errRadarrCalendar)
-			} else {
-				log.Printf("✓ Found %d upcoming movies", len(upcomingMovies))
+			log.Printf("📚 Fetching %s calendar...", inst.Name)
+			books, err := fetchReadarrCalendarWithRetry(ctx, cfg, inst, weekEnd, upcomingEnd, retries)
+			if err != nil {
+				log.Printf("⚠️  %s calendar error: %v", inst.Name, err)
+				readarrCalendarErrs[i] = err
+				return
 			}
-		}()
-	} else {
-		log.Println("🎬 Radarr not configured, skipping...")
+			readarrCalendar[i] = books
+			log.Printf("✓ Found %d upcoming books in %s", len(books), inst.Name)
+		}(i, inst)
 	}
 
 	// Fetch Trakt data if enabled
 	if cfg.ShowTraktAnticipatedSeries {
+		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			log.Println("🔥 Fetching Trakt anticipated series...")
-			series, err := fetchTraktAnticipatedSeries(ctx, cfg)
+			series, err := fetchTraktAnticipatedSeries(ctx, cfg, period)
 			if err != nil {
 				log.Printf("⚠️  Trakt anticipated series error: %v", err)
 			} else {
@@ -165,10 +397,11 @@ func runNewsletter() {
 	}
 
 	if cfg.ShowTraktWatchedSeries {
+		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			log.Println("👀 Fetching Trakt watched series...")
-			series, err := fetchTraktWatchedSeries(ctx, cfg)
+			series, err := fetchTraktWatchedSeries(ctx, cfg, period)
 			if err != nil {
 				log.Printf("⚠️  Trakt watched series error: %v", err)
 			} else {
@@ -179,10 +412,11 @@ func runNewsletter() {
 	}
 
 	if cfg.ShowTraktAnticipatedMovies {
+		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			log.Println("🔥 Fetching Trakt anticipated movies...")
-			movies, err := fetchTraktAnticipatedMovies(ctx, cfg)
+			movies, err := fetchTraktAnticipatedMovies(ctx, cfg, period)
 			if err != nil {
 				log.Printf("⚠️  Trakt anticipated movies error: %v", err)
 			} else {
@@ -193,10 +427,11 @@ func runNewsletter() {
 	}
 
 	if cfg.ShowTraktWatchedMovies {
+		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			log.Println("👀 Fetching Trakt watched movies...")
-			movies, err := fetchTraktWatchedMovies(ctx, cfg)
+			movies, err := fetchTraktWatchedMovies(ctx, cfg, period)
 			if err != nil {
 				log.Printf("⚠️  Trakt watched movies error: %v", err)
 			} else {
@@ -206,34 +441,259 @@ func runNewsletter() {
 		}()
 	}
 
+	// Fetch the personal Trakt sections if an account is connected
+	if cfg.TraktClientID != "" && traktConnected() {
+		if cfg.ShowTraktWatchlist {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				log.Println("📌 Fetching Trakt watchlist...")
+				shows, movies, err := fetchTraktWatchlist(ctx, cfg)
+				if err != nil {
+					log.Printf("⚠️  Trakt watchlist error: %v", err)
+				} else {
+					traktWatchlistShows, traktWatchlistMovies = shows, movies
+				}
+			}()
+		}
+
+		if cfg.ShowTraktRecommendations {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				log.Println("💡 Fetching Trakt recommendations...")
+				shows, movies, err := fetchTraktRecommendations(ctx, cfg)
+				if err != nil {
+					log.Printf("⚠️  Trakt recommendations error: %v", err)
+				} else {
+					traktRecommendedShows, traktRecommendedMovies = shows, movies
+					log.Printf("✓ Found %d recommended series and %d recommended movies", len(shows), len(movies))
+				}
+			}()
+		}
+
+		if cfg.ShowTraktCalendar {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				log.Println("🗓️  Fetching Trakt calendar...")
+				shows, movies, err := fetchTraktCalendar(ctx, cfg, weekEnd, upcomingEnd)
+				if err != nil {
+					log.Printf("⚠️  Trakt calendar error: %v", err)
+				} else {
+					traktCalendarShows, traktCalendarMovies = shows, movies
+					log.Printf("✓ Found %d series and %d movies on the Trakt calendar", len(shows), len(movies))
+				}
+			}()
+		}
+	}
+
+	// Fetch custom Trakt list sections
+	if cfg.TraktClientID != "" {
+		for i, list := range cfg.TraktLists {
+			wg.Add(1)
+			go func(i int, list TraktList) {
+				defer wg.Done()
+				log.Printf("📋 Fetching Trakt list %s...", list.Heading)
+				section, err := fetchTraktList(ctx, cfg, list)
+				if err != nil {
+					log.Printf("⚠️  Trakt list %s error: %v", list.Heading, err)
+					return
+				}
+				traktListSections[i] = section
+				log.Printf("✓ Found %d series and %d movies on Trakt list %s", len(section.Shows), len(section.Movies), list.Heading)
+			}(i, list)
+		}
+	}
+
+	// Fetch Lidarr music releases if configured
+	if lidarrConfigured(cfg) && cfg.ShowMusic {
+		wg.Add(2) // history + calendar
+		go func() {
+			defer wg.Done()
+			log.Println("🎵 Fetching Lidarr history...")
+			albums, err := fetchLidarrHistoryWithRetry(ctx, cfg, weekStart, retries)
+			if err != nil {
+				log.Printf("⚠️  Lidarr history error: %v", err)
+				lidarrHistoryErr = err
+			} else {
+				downloadedAlbums = albums
+				log.Printf("✓ Found %d downloaded albums", len(albums))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			log.Println("🎵 Fetching Lidarr calendar...")
+			albums, err := fetchLidarrCalendarWithRetry(ctx, cfg, weekEnd, upcomingEnd, retries)
+			if err != nil {
+				log.Printf("⚠️  Lidarr calendar error: %v", err)
+				lidarrCalendarErr = err
+			} else {
+				upcomingAlbums = albums
+				log.Printf("✓ Found %d upcoming albums", len(albums))
+			}
+		}()
+	}
+
+	// Fetch media server (Jellyfin/Emby) data if configured
+	if jellyfinConfigured(cfg) {
+		serverName := jellyfinServerName(cfg.JellyfinServerType)
+
+		if cfg.ShowServerMostWatched {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				log.Printf("▶️  Fetching %s most watched...", serverName)
+				items, err := fetchJellyfinMostWatched(ctx, cfg, weekStart, weekEnd)
+				if err != nil {
+					log.Printf("⚠️  %s most watched error: %v", serverName, err)
+				} else {
+					serverMostWatched = items
+					log.Printf("✓ Found %d most watched items in %s", len(items), serverName)
+				}
+			}()
+		}
+
+		if cfg.ShowServerRecentlyAdded {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				log.Printf("🆕 Fetching %s recently added...", serverName)
+				items, err := fetchJellyfinRecentlyAdded(ctx, cfg, weekStart)
+				if err != nil {
+					log.Printf("⚠️  %s recently added error: %v", serverName, err)
+				} else {
+					serverRecentlyAdded = items
+					log.Printf("✓ Found %d recently added items in %s", len(items), serverName)
+				}
+			}()
+		}
+	}
+
+	// Fetch Plex library (for deep links) and recently added if configured
+	if plexConfigured(cfg) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			log.Println("🎞️  Fetching Plex library...")
+			library, err := getPlexLibrary(ctx, cfg)
+			if err != nil {
+				log.Printf("⚠️  Plex library error: %v", err)
+				return
+			}
+			plexLibrary = library
+
+			if cfg.ShowServerRecentlyAdded {
+				items, err := fetchPlexRecentlyAdded(ctx, cfg, library, weekStart)
+				if err != nil {
+					log.Printf("⚠️  Plex recently added error: %v", err)
+				} else {
+					plexRecentlyAdded = items
+					log.Printf("✓ Found %d recently added items in Plex", len(items))
+				}
+			}
+		}()
+	}
+
+	// Fetch Tautulli viewing statistics if configured
+	if tautulliConfigured(cfg) && cfg.ShowServerStats {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			log.Println("📊 Fetching Tautulli stats...")
+			stats, err := fetchTautulliStats(ctx, cfg, weekStart)
+			if err != nil {
+				log.Printf("⚠️  Tautulli stats error: %v", err)
+				return
+			}
+			serverStats = stats
+			log.Printf("✓ Found %d top movies, %d top shows and %d platforms in Tautulli",
+				len(stats.TopMovies), len(stats.TopShows), len(stats.TopPlatforms))
+		}()
+	}
+
+	// Fetch Overseerr/Jellyseerr requests (for requester names and pending requests) if configured
+	if overseerrConfigured(cfg) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			log.Println("🙋 Fetching Overseerr requests...")
+			requests, err := fetchOverseerrRequests(ctx, cfg)
+			if err != nil {
+				log.Printf("⚠️  Overseerr requests error: %v", err)
+				return
+			}
+			overseerrRequests = requests
+
+			if cfg.ShowPendingRequests {
+				pendingRequests = fetchPendingRequests(ctx, cfg, requests, cfg.PendingRequestsLimit)
+				log.Printf("✓ Found %d pending requests in Overseerr", len(pendingRequests))
+			}
+		}()
+	}
+
 	wg.Wait()
 	fetchDuration := time.Since(startFetch)
 	log.Printf("⚡ All data fetched in %v (parallel)", fetchDuration)
 
+	// Merge results from all instances (duplicates are removed further down by TVDB/TMDB ID)
+	var downloadedEpisodes, upcomingEpisodes []Episode
+	var downloadedMovies, upcomingMovies []Movie
+	for i := range sonarrInstances {
+		downloadedEpisodes = append(downloadedEpisodes, sonarrHistory[i]...)
+		upcomingEpisodes = append(upcomingEpisodes, sonarrCalendar[i]...)
+	}
+	for i := range radarrInstances {
+		downloadedMovies = append(downloadedMovies, radarrHistory[i]...)
+		upcomingMovies = append(upcomingMovies, radarrCalendar[i]...)
+	}
+	var downloadedBooks, upcomingBooks []Book
+	for i := range readarrInstances {
+		downloadedBooks = append(downloadedBooks, readarrHistory[i]...)
+		upcomingBooks = append(upcomingBooks, readarrCalendar[i]...)
+	}
+
 	// Check for partial failures and provide graceful degradation
 	failedServices := []string{}
 	workingServices := []string{}
 
-	if errSonarrHistory != nil || errSonarrCalendar != nil {
-		failedServices = append(failedServices, "Sonarr")
-	} else {
-		workingServices = append(workingServices, "Sonarr")
+	for i, inst := range sonarrInstances {
+		if sonarrHistoryErrs[i] != nil || sonarrCalendarErrs[i] != nil {
+			failedServices = append(failedServices, inst.Name)
+		} else {
+			workingServices = append(workingServices, inst.Name)
+		}
 	}
-
-	if errRadarrHistory != nil || errRadarrCalendar != nil {
-		failedServices = append(failedServices, "Radarr")
-	} else {
-		workingServices = append(workingServices, "Radarr")
+	for i, inst := range radarrInstances {
+		if radarrHistoryErrs[i] != nil || radarrCalendarErrs[i] != nil {
+			failedServices = append(failedServices, inst.Name)
+		} else {
+			workingServices = append(workingServices, inst.Name)
+		}
+	}
+	for i, inst := range readarrInstances {
+		if readarrHistoryErrs[i] != nil || readarrCalendarErrs[i] != nil {
+			failedServices = append(failedServices, inst.Name)
+		} else {
+			workingServices = append(workingServices, inst.Name)
+		}
+	}
+	if lidarrConfigured(cfg) && cfg.ShowMusic {
+		if lidarrHistoryErr != nil || lidarrCalendarErr != nil {
+			failedServices = append(failedServices, "Lidarr")
+		} else {
+			workingServices = append(workingServices, "Lidarr")
+		}
 	}
 
 	// Log graceful degradation status
+	var fetchErr error
 	if len(failedServices) > 0 && len(workingServices) > 0 {
 		log.Printf("⚠️  Graceful degradation: %s failed, continuing with %s only",
 			strings.Join(failedServices, ", "),
 			strings.Join(workingServices, ", "))
 	} else if len(failedServices) > 0 && len(workingServices) == 0 {
-		log.Printf("❌ All services failed - cannot generate newsletter")
-		return
+		fetchErr = fmt.Errorf("all services failed (%s)", strings.Join(failedServices, ", "))
 	}
 
 	// Filter unmonitored items from next week releases only (last week already downloaded)
@@ -241,19 +701,76 @@ func runNewsletter() {
 		log.Println("📋 Filtering out unmonitored items from upcoming releases...")
 		upcomingEpisodes = filterMonitoredEpisodes(upcomingEpisodes)
 		upcomingMovies = filterMonitoredMovies(upcomingMovies)
-		log.Printf("✓ After filtering: %d upcoming episodes, %d upcoming movies",
-			len(upcomingEpisodes), len(upcomingMovies))
+		upcomingAlbums = filterMonitoredAlbums(upcomingAlbums)
+		upcomingBooks = filterMonitoredBooks(upcomingBooks)
+		log.Printf("✓ After filtering: %d upcoming episodes, %d upcoming movies, %d upcoming albums, %d upcoming books",
+			len(upcomingEpisodes), len(upcomingMovies), len(upcomingAlbums), len(upcomingBooks))
 	}
 
-	// Check if we have any content to send
-	hasContent := len(upcomingEpisodes) > 0 || len(upcomingMovies) > 0 ||
-		(cfg.ShowDownloaded && (len(downloadedEpisodes) > 0 || len(downloadedMovies) > 0))
+	// Deduplicate episodes and movies (also merges the same title reported by several instances)
+	upcomingEpisodes = deduplicateEpisodes(upcomingEpisodes)
+	downloadedEpisodes = deduplicateEpisodes(downloadedEpisodes)
+	upcomingMovies = deduplicateMovies(upcomingMovies)
+	downloadedMovies = deduplicateMovies(downloadedMovies)
+	upcomingBooks = deduplicateBooks(upcomingBooks)
+	downloadedBooks = deduplicateBooks(downloadedBooks)
 
-	if !hasContent {
-		log.Println("ℹ️  No new content to report. Skipping email.")
-		return
+	// Link movies that are on the Plex server
+	if plexLibrary != nil {
+		for i := range upcomingMovies {
+			upcomingMovies[i].PlexURL = plexLibrary.movieURL(upcomingMovies[i].IMDBID, upcomingMovies[i].TmdbID)
+		}
+		for i := range downloadedMovies {
+			downloadedMovies[i].PlexURL = plexLibrary.movieURL(downloadedMovies[i].IMDBID, downloadedMovies[i].TmdbID)
+		}
+	}
+
+	// Combine the download queues of every instance
+	var queueItems []QueueItem
+	for i := range sonarrInstances {
+		queueItems = append(queueItems, sonarrQueue[i]...)
+	}
+	for i := range radarrInstances {
+		queueItems = append(queueItems, radarrQueue[i]...)
+	}
+	queueItems = mergeQueueItems(queueItems, cfg.QueueLimit)
+
+	// Combine the missing items of every instance
+	var missingItems []MissingItem
+	for i := range sonarrInstances {
+		missingItems = append(missingItems, sonarrMissing[i]...)
+	}
+	for i := range radarrInstances {
+		missingItems = append(missingItems, radarrMissing[i]...)
+	}
+	missingItems, missingTotal := mergeMissingItems(missingItems, cfg.MissingLimit)
+
+	// Show which subtitle languages the downloaded episodes and movies have
+	addSubtitles(ctx, cfg, downloadedEpisodes, downloadedMovies)
+
+	// Show who requested the downloaded movies
+	if overseerrRequests != nil {
+		for i := range downloadedMovies {
+			downloadedMovies[i].RequestedBy = overseerrRequests.movieRequesters(downloadedMovies[i].TmdbID)
+		}
 	}
 
+	// Keep the watchlist titles that became available during this period
+	traktWatchlistShows, traktWatchlistMovies = watchlistNowAvailable(traktWatchlistShows, traktWatchlistMovies, downloadedEpisodes, downloadedMovies)
+	addTraktShowImages(ctx, cfg, traktWatchlistShows)
+	addTraktMovieImages(ctx, cfg, traktWatchlistMovies)
+
+	// Drop custom list sections that failed or came back empty
+	listSections := []TraktListSection{}
+	for _, section := range traktListSections {
+		if len(section.Shows) > 0 || len(section.Movies) > 0 {
+			listSections = append(listSections, section)
+		}
+	}
+
+	// Combine Jellyfin/Emby and Plex recently added
+	serverRecentlyAdded = mergeServerItems(serverRecentlyAdded, plexRecentlyAdded, cfg.ServerRecentlyAddedLimit)
+
 	// Sort movies chronologically
 	sort.Slice(upcomingMovies, func(i, j int) bool {
 		return upcomingMovies[i].ReleaseDate < upcomingMovies[j].ReleaseDate
@@ -262,6 +779,20 @@ func runNewsletter() {
 		return downloadedMovies[i].ReleaseDate < downloadedMovies[j].ReleaseDate
 	})
 
+	// Sort albums chronologically as well
+	sort.Slice(upcomingAlbums, func(i, j int) bool {
+		return upcomingAlbums[i].ReleaseDate < upcomingAlbums[j].ReleaseDate
+	})
+	sort.Slice(downloadedAlbums, func(i, j int) bool {
+		return downloadedAlbums[i].ReleaseDate < downloadedAlbums[j].ReleaseDate
+	})
+	sort.Slice(upcomingBooks, func(i, j int) bool {
+		return upcomingBooks[i].ReleaseDate < upcomingBooks[j].ReleaseDate
+	})
+	sort.Slice(downloadedBooks, func(i, j int) bool {
+		return downloadedBooks[i].ReleaseDate < downloadedBooks[j].ReleaseDate
+	})
+
 	// Select appropriate strings based on schedule type
 	var emailTitle, weekRangePrefix, comingThisWeekHeading string
 	var noShowsMessage, noMoviesMessage string
@@ -314,25 +845,37 @@ func runNewsletter() {
 		upcomingEndStr = upcomingEnd.Format("January 2, 2006")
 	}
 
-	// Deduplicate episodes and movies
-	upcomingEpisodes = deduplicateEpisodes(upcomingEpisodes)
-	downloadedEpisodes = deduplicateEpisodes(downloadedEpisodes)
-	upcomingMovies = deduplicateMovies(upcomingMovies)
-	downloadedMovies = deduplicateMovies(downloadedMovies)
-
 	data := NewsletterData{
 		WeekStart:              weekStartStr,
 		WeekEnd:                weekEndStr,
 		UpcomingStart:          upcomingStartStr,
 		UpcomingEnd:            upcomingEndStr,
-		UpcomingSeriesGroups:   groupEpisodesBySeries(upcomingEpisodes),
+		UpcomingSeriesGroups:   linkPlexSeries(groupEpisodesBySeries(upcomingEpisodes), plexLibrary),
 		UpcomingMovies:         upcomingMovies,
-		DownloadedSeriesGroups: groupEpisodesBySeries(downloadedEpisodes),
+		DownloadedSeriesGroups: linkRequestedSeries(linkPlexSeries(groupEpisodesBySeries(downloadedEpisodes), plexLibrary), overseerrRequests),
 		DownloadedMovies:       downloadedMovies,
 		TraktAnticipatedSeries: traktAnticipatedSeries,
 		TraktWatchedSeries:     traktWatchedSeries,
 		TraktAnticipatedMovies: traktAnticipatedMovies,
 		TraktWatchedMovies:     traktWatchedMovies,
+		ServerMostWatched:      serverMostWatched,
+		ServerRecentlyAdded:    serverRecentlyAdded,
+		UpcomingAlbums:         upcomingAlbums,
+		DownloadedAlbums:       downloadedAlbums,
+		UpcomingBooks:          upcomingBooks,
+		DownloadedBooks:        downloadedBooks,
+		PendingRequests:        pendingRequests,
+		ServerStats:            serverStats,
+		QueueItems:             queueItems,
+		MissingItems:           missingItems,
+		MissingTotal:           missingTotal,
+		TraktWatchlistShows:    traktWatchlistShows,
+		TraktWatchlistMovies:   traktWatchlistMovies,
+		TraktRecommendedShows:  traktRecommendedShows,
+		TraktRecommendedMovies: traktRecommendedMovies,
+		TraktCalendarShows:     traktCalendarShows,
+		TraktCalendarMovies:    traktCalendarMovies,
+		TraktListSections:      listSections,
 		// Customizable strings (schedule-aware)
 		EmailTitle:                emailTitle,
 		EmailIntro:                cfg.EmailIntro,
@@ -350,9 +893,21 @@ func runNewsletter() {
 		WatchedSeriesHeading:      watchedSeriesHeading,
 		AnticipatedMoviesHeading:  anticipatedMoviesHeading,
 		WatchedMoviesHeading:      watchedMoviesHeading,
+		MostWatchedHeading:        cfg.MostWatchedHeading,
+		RecentlyAddedHeading:      cfg.RecentlyAddedHeading,
+		MusicHeading:              cfg.MusicHeading,
+		BooksHeading:              cfg.BooksHeading,
+		PendingRequestsHeading:    cfg.PendingRequestsHeading,
+		ServerStatsHeading:        cfg.ServerStatsHeading,
+		QueueHeading:              cfg.QueueHeading,
+		MissingHeading:            cfg.MissingHeading,
+		TraktWatchlistHeading:     cfg.TraktWatchlistHeading,
+		RecommendationsHeading:    cfg.RecommendationsHeading,
+		TraktCalendarHeading:      cfg.TraktCalendarHeading,
 		FooterText:                cfg.FooterText,
 		// Display options
 		ShowPosters:                cfg.ShowPosters,
+		ShowTraktPosters:           cfg.ShowPosters && cfg.TMDBAPIKey != "",
 		ShowDownloaded:             cfg.ShowDownloaded,
 		ShowSeriesOverview:         cfg.ShowSeriesOverview,
 		ShowEpisodeOverview:        cfg.ShowEpisodeOverview,
@@ -362,47 +917,10 @@ func runNewsletter() {
 		ShowTraktWatchedSeries:     cfg.ShowTraktWatchedSeries,
 		ShowTraktAnticipatedMovies: cfg.ShowTraktAnticipatedMovies,
 		ShowTraktWatchedMovies:     cfg.ShowTraktWatchedMovies,
+		ShowInstanceLabels:         len(sonarrInstances) > 1 || len(radarrInstances) > 1 || len(readarrInstances) > 1,
 	}
 
-	log.Println("📝 Generating newsletter HTML...")
-	html, err := generateNewsletterHTML(data, cfg)
-	if err != nil {
-		log.Fatalf("❌ Failed to generate HTML: %v", err)
-	}
-
-	// Generate subject line based on schedule type
-	var subject string
-	if cfg.ScheduleType == "monthly" {
-		subject = fmt.Sprintf("📺 Your Monthly Newsletter - %s", weekEnd.Format("January 2006"))
-	} else {
-		subject = fmt.Sprintf("📺 Your Weekly Newsletter - %s", weekEnd.Format("January 2, 2006"))
-	}
-
-	log.Println("📧 Sending emails...")
-	if err := sendEmail(cfg, subject, html); err != nil {
-		log.Fatalf("❌ Failed to send email: %v", err)
-	}
-
-	// Update statistics after successful send
-	stats.mu.Lock()
-	stats.TotalEmailsSent += len(cfg.ToEmails)
-	stats.LastSentDate = now
-	stats.LastSentDateStr = now.Format("2006-01-02 15:04:05 MST")
-	stats.mu.Unlock()
-
-	// Persist statistics to disk
-	if err := saveStats(); err != nil {
-		log.Printf("⚠️  Failed to save statistics: %v", err)
-	}
-
-	log.Println("✅ Newsletter sent successfully!")
-
-	// Clear data to free memory immediately
-	downloadedEpisodes = nil
-	upcomingEpisodes = nil
-	downloadedMovies = nil
-	upcomingMovies = nil
-	data = NewsletterData{}
+	return data, fetchErr
 }
 
 // Generate newsletter HTML using precompiled template
@@ -415,43 +933,58 @@ func generateNewsletterHTML(data NewsletterData, cfg *Config) (string, error) {
 	return buf.String(), nil
 }
 
-// Send email (with batch support for large recipient lists)
-func sendEmail(cfg *Config, subject, htmlBody string) error {
-	if cfg.FromEmail == "" || len(cfg.ToEmails) == 0 {
+// Generate the plain-text alternative of the newsletter
+func generateNewsletterText(data NewsletterData) (string, error) {
+	var buf bytes.Buffer
+	if err := emailTextTemplate.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// Send email to the newsletter recipients
+func sendEmail(cfg *Config, subject string, body emailBody) error {
+	return sendEmailTo(cfg, cfg.ToEmails, subject, body)
+}
+
+// Send email to the given recipients (with batch support for large recipient lists)
+func sendEmailTo(cfg *Config, recipients []string, subject string, body emailBody) error {
+	if cfg.FromEmail == "" || len(recipients) == 0 {
 		return fmt.Errorf("email configuration incomplete")
 	}
 
 	// If recipients fit in one batch, send normally
-	if len(cfg.ToEmails) <= cfg.EmailBatchSize {
-		return sendEmailBatch(cfg, subject, htmlBody, cfg.ToEmails)
+	if len(recipients) <= cfg.EmailBatchSize {
+		return sendEmailBatch(cfg, subject, body, recipients)
 	}
 
 	// Send in batches to avoid SMTP rate limits
-	log.Printf("📨 Sending to %d recipients in batches of %d...", len(cfg.ToEmails), cfg.EmailBatchSize)
+	log.Printf("📨 Sending to %d recipients in batches of %d...", len(recipients), cfg.EmailBatchSize)
 
-	for i := 0; i < len(cfg.ToEmails); i += cfg.EmailBatchSize {
+	for i := 0; i < len(recipients); i += cfg.EmailBatchSize {
 		end := i + cfg.EmailBatchSize
-		if end > len(cfg.ToEmails) {
-			end = len(cfg.ToEmails)
+		if end > len(recipients) {
+			end = len(recipients)
 		}
-		batch := cfg.ToEmails[i:end]
+		batch := recipients[i:end]
 
 		log.Printf("📧 Sending batch %d/%d (%d recipients)...",
 			(i/cfg.EmailBatchSize)+1,
-			(len(cfg.ToEmails)+cfg.EmailBatchSize-1)/cfg.EmailBatchSize,
+			(len(recipients)+cfg.EmailBatchSize-1)/cfg.EmailBatchSize,
 			len(batch))
 
-		if err := sendEmailBatch(cfg, subject, htmlBody, batch); err != nil {
+		if err := sendEmailBatch(cfg, subject, body, batch); err != nil {
 			return fmt.Errorf("batch %d failed: %w", (i/cfg.EmailBatchSize)+1, err)
 		}
 
 		// Add delay between batches (except for the last batch)
-		if end < len(cfg.ToEmails) {
+		if end < len(recipients) {
 			time.Sleep(time.Duration(cfg.EmailBatchDelay) * time.Second)
 		}
 	}
 
-	log.Printf("✅ Successfully sent to all %d recipients", len(cfg.ToEmails))
+	log.Printf("✅ Successfully sent to all %d recipients", len(recipients))
 	return nil
 }
 
@@ -460,8 +993,13 @@ func sanitizeHeader(value string) string {
 	return strings.NewReplacer("\r", "", "\n", "").Replace(value)
 }
 
-// Send email to a single batch of recipients with TLS enforcement
-func sendEmailBatch(cfg *Config, subject, htmlBody string, recipients []string) error {
+// Send email to a single batch of recipients over the configured SMTP security mode
+func sendEmailBatch(cfg *Config, subject string, body emailBody, recipients []string) error {
+	entity, err := buildEmailEntity(body)
+	if err != nil {
+		return fmt.Errorf("failed to build message: %w", err)
+	}
+
 	from := cfg.FromEmail
 	if cfg.FromName != "" {
 		from = fmt.Sprintf("%s <%s>", cfg.FromName, cfg.FromEmail)
@@ -472,51 +1010,22 @@ func sendEmailBatch(cfg *Config, subject, htmlBody string, recipients []string)
 	headers["To"] = sanitizeHeader(strings.Join(recipients, ", "))
 	headers["Subject"] = sanitizeHeader(subject)
 	headers["MIME-Version"] = "1.0"
-	headers["Content-Type"] = "text/html; charset=UTF-8"
+	for k := range entity.Header {
+		headers[k] = entity.Header.Get(k)
+	}
 
 	message := ""
 	for k, v := range headers {
 		message += fmt.Sprintf("%s: %s\r\n", k, v)
 	}
-	message += "\r\n" + htmlBody
-
-	addr := fmt.Sprintf("%s:%s", cfg.SMTPHost, cfg.SMTPPort)
+	message += "\r\n" + string(entity.Body)
 
-	// Connect to SMTP server
-	client, err := smtp.Dial(addr)
+	client, _, err := dialSMTP(cfg)
 	if err != nil {
-		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+		return err
 	}
 	defer client.Close()
 
-	// Send EHLO
-	if err = client.Hello("localhost"); err != nil {
-		return fmt.Errorf("EHLO failed: %w", err)
-	}
-
-	// Try STARTTLS if available (with secure TLS configuration)
-	if ok, _ := client.Extension("STARTTLS"); ok {
-		tlsConfig := &tls.Config{
-			ServerName: cfg.SMTPHost,
-			MinVersion: tls.VersionTLS12,
-			CipherSuites: []uint16{
-				tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
-				tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
-				tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
-				tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
-			},
-		}
-		if err = client.StartTLS(tlsConfig); err != nil {
-			return fmt.Errorf("STARTTLS failed: %w", err)
-		}
-	}
-
-	// Authenticate
-	auth := smtp.PlainAuth("", cfg.SMTPUser, cfg.SMTPPass, cfg.SMTPHost)
-	if err = client.Auth(auth); err != nil {
-		return fmt.Errorf("authentication failed: %w", err)
-	}
-
 	// Set sender
 	if err = client.Mail(cfg.FromEmail); err != nil {
 		return fmt.Errorf("failed to set sender: %w", err)
@@ -552,6 +1061,9 @@ func initEmailTemplate() (*template.Template, error) {
 	return template.New("email.html").Funcs(template.FuncMap{
 		"formatDateWithDay": formatDateWithDay,
 		"truncate":          truncateString,
+		"join":              strings.Join,
+		"sub":               func(a, b int) int { return a - b },
+		"add":               func(a, b int) int { return a + b },
 	}).ParseFS(templateFS, "templates/email.html")
 }
 
@@ -619,7 +1131,7 @@ func getNextScheduledRun(day, timeStr, scheduleType string, dayOfMonth int, loc
 
 // Monitorable is a constraint for types that have a Monitored field
 type Monitorable interface {
-	Episode | Movie
+	Episode | Movie | Album | Book
 }
 
 // Generic filter function to exclude unmonitored items - eliminates code duplication
@@ -633,6 +1145,10 @@ func filterMonitored[T Monitorable](items []T) []T {
 			monitored = any(item).(Episode).Monitored
 		case Movie:
 			monitored = any(item).(Movie).Monitored
+		case Album:
+			monitored = any(item).(Album).Monitored
+		case Book:
+			monitored = any(item).(Book).Monitored
 		}
 		if monitored {
 			filtered = append(filtered, item)
@@ -649,3 +1165,11 @@ func filterMonitoredEpisodes(episodes []Episode) []Episode {
 func filterMonitoredMovies(movies []Movie) []Movie {
 	return filterMonitored[Movie](movies)
 }
+
+func filterMonitoredAlbums(albums []Album) []Album {
+	return filterMonitored[Album](albums)
+}
+
+func filterMonitoredBooks(books []Book) []Book {
+	return filterMonitored[Book](books)
+}
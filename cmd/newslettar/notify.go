@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+)
+
+// Notifier delivers a generated newsletter issue to one destination (email, Discord, ...).
+// runNewsletter fans every issue out to all configured notifiers in parallel.
+type Notifier interface {
+	Name() string
+	Send(ctx context.Context, issue *NewsletterIssue) error
+}
+
+// NewsletterIssue is one generated newsletter, rendered once and shared by all notifiers
+type NewsletterIssue struct {
+	Subject    string
+	HTML       string
+	Text       string // Plain-text alternative, empty when it couldn't be rendered
+	Data       NewsletterData
+	Period     newsletterPeriod
+	ArchiveURL string // Public link to the archived HTML copy, empty when PUBLIC_URL isn't set
+}
+
+// emailNotifier sends the HTML newsletter to the configured recipients over SMTP
+type emailNotifier struct {
+	cfg *Config
+}
+
+func (n emailNotifier) Name() string { return "email" }
+
+func (n emailNotifier) Send(ctx context.Context, issue *NewsletterIssue) error {
+	var attachments []emailAttachment
+	if n.cfg.EmailAttachICS && (len(issue.Data.UpcomingSeriesGroups) > 0 || len(issue.Data.UpcomingMovies) > 0) {
+		attachments = append(attachments, emailAttachment{
+			Filename:    "upcoming.ics",
+			ContentType: "text/calendar; charset=UTF-8; method=PUBLISH",
+			Data:        []byte(buildICS(n.cfg, issue.Subject, issue.Data.UpcomingSeriesGroups, issue.Data.UpcomingMovies)),
+		})
+	}
+	htmlBody := issue.HTML
+	if n.cfg.EmailInlinePosters {
+		var posters []emailAttachment
+		htmlBody, posters = inlinePosters(ctx, n.cfg, htmlBody)
+		attachments = append(attachments, posters...)
+	}
+	return sendEmail(n.cfg, issue.Subject, emailBody{HTML: htmlBody, Text: issue.Text, Attachments: attachments})
+}
+
+// publicPosterURL returns the poster when posters are enabled and it is an absolute http(s) URL.
+// Chat services fetch images themselves and can't load relative Sonarr/Radarr media cover paths.
+func publicPosterURL(data NewsletterData, posterURL string) string {
+	if !data.ShowPosters || !(strings.HasPrefix(posterURL, "https://") || strings.HasPrefix(posterURL, "http://")) {
+		return ""
+	}
+	return posterURL
+}
+
+// truncateRunes shortens s to at most max characters; chat services count characters, not bytes
+func truncateRunes(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max-1]) + "…"
+}
+
+// pluralize returns "1 movie" or "2 movies"
+func pluralize(n int, word string) string {
+	if n == 1 {
+		return "1 " + word
+	}
+	return fmt.Sprintf("%d %ss", n, word)
+}
+
+// countEpisodes returns the number of episodes across the series groups
+func countEpisodes(groups []SeriesGroup) int {
+	n := 0
+	for _, group := range groups {
+		n += len(group.Episodes)
+	}
+	return n
+}
+
+// newsletterSummary returns a one-line summary for push notifications,
+// e.g. "5 episodes, 2 movies arriving this week; 3 episodes downloaded last week"
+func newsletterSummary(data NewsletterData, period newsletterPeriod) string {
+	span := "week"
+	if period.Monthly {
+		span = "month"
+	}
+	counts := func(episodes, movies int) string {
+		var parts []string
+		if episodes > 0 {
+			parts = append(parts, pluralize(episodes, "episode"))
+		}
+		if movies > 0 {
+			parts = append(parts, pluralize(movies, "movie"))
+		}
+		return strings.Join(parts, ", ")
+	}
+
+	var summary []string
+	if upcoming := counts(countEpisodes(data.UpcomingSeriesGroups), len(data.UpcomingMovies)); upcoming != "" {
+		summary = append(summary, upcoming+" arriving this "+span)
+	}
+	if data.ShowDownloaded {
+		if downloaded := counts(countEpisodes(data.DownloadedSeriesGroups), len(data.DownloadedMovies)); downloaded != "" {
+			summary = append(summary, downloaded+" downloaded last "+span)
+		}
+	}
+	if len(summary) == 0 {
+		return "A new newsletter is out"
+	}
+	return strings.Join(summary, "; ")
+}
+
+// configuredNotifiers returns a notifier for every delivery method with complete configuration
+func configuredNotifiers(cfg *Config) []Notifier {
+	var notifiers []Notifier
+	if cfg.FromEmail != "" && len(cfg.ToEmails) > 0 {
+		notifiers = append(notifiers, emailNotifier{cfg: cfg})
+	}
+	if cfg.SlackWebhookURL != "" {
+		notifiers = append(notifiers, slackNotifier{cfg: cfg})
+	}
+	if cfg.DiscordWebhookURL != "" {
+		notifiers = append(notifiers, discordNotifier{cfg: cfg})
+	}
+	if cfg.TelegramBotToken != "" && len(cfg.TelegramChatIDs) > 0 {
+		notifiers = append(notifiers, telegramNotifier{cfg: cfg})
+	}
+	if cfg.MatrixHomeserverURL != "" && cfg.MatrixAccessToken != "" && cfg.MatrixRoomID != "" {
+		notifiers = append(notifiers, matrixNotifier{cfg: cfg})
+	}
+	if cfg.NtfyTopic != "" {
+		notifiers = append(notifiers, ntfyNotifier{cfg: cfg})
+	}
+	if cfg.GotifyURL != "" && cfg.GotifyToken != "" {
+		notifiers = append(notifiers, gotifyNotifier{cfg: cfg})
+	}
+	if cfg.WebhookURL != "" {
+		notifiers = append(notifiers, webhookNotifier{cfg: cfg})
+	}
+	return notifiers
+}
+
+// deliverNewsletter sends the issue through every notifier in parallel.
+// Returns the names of the notifiers that succeeded and the joined errors of those that failed.
+func deliverNewsletter(ctx context.Context, notifiers []Notifier, issue *NewsletterIssue) (map[string]bool, error) {
+	if len(notifiers) == 0 {
+		return nil, fmt.Errorf("no delivery method configured")
+	}
+
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		delivered = make(map[string]bool)
+		errs      []error
+	)
+	for _, n := range notifiers {
+		wg.Add(1)
+		go func(n Notifier) {
+			defer wg.Done()
+			log.Printf("📨 Sending newsletter via %s...", n.Name())
+			err := n.Send(ctx, issue)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				log.Printf("❌ %s delivery failed: %v", n.Name(), err)
+				errs = append(errs, fmt.Errorf("%s: %w", n.Name(), err))
+				return
+			}
+			log.Printf("✅ Newsletter sent via %s", n.Name())
+			delivered[n.Name()] = true
+		}(n)
+	}
+	wg.Wait()
+
+	return delivered, errors.Join(errs...)
+}
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Overseerr and Jellyseerr share the same REST API (Jellyseerr is a fork of Overseerr),
+// so one client serves both. Authentication uses the X-Api-Key header.
+
+// Overseerr request and media status codes
+const (
+	overseerrRequestApproved = 2
+
+	overseerrMediaProcessing         = 3
+	overseerrMediaPartiallyAvailable = 4
+	overseerrMediaAvailable          = 5
+)
+
+// overseerrRequest is the subset of an Overseerr MediaRequest we need
+type overseerrRequest struct {
+	ID        int       `json:"id"`
+	Status    int       `json:"status"`
+	Type      string    `json:"type"` // "movie" or "tv"
+	CreatedAt time.Time `json:"createdAt"`
+	Media     struct {
+		TmdbID int `json:"tmdbId"`
+		TvdbID int `json:"tvdbId"`
+		Status int `json:"status"`
+	} `json:"media"`
+	RequestedBy struct {
+		DisplayName  string `json:"displayName"`
+		Username     string `json:"username"`
+		PlexUsername string `json:"plexUsername"`
+		Email        string `json:"email"`
+	} `json:"requestedBy"`
+}
+
+// requesterName returns the best available display name of the requesting user
+func (r overseerrRequest) requesterName() string {
+	for _, name := range []string{r.RequestedBy.DisplayName, r.RequestedBy.Username, r.RequestedBy.PlexUsername, r.RequestedBy.Email} {
+		if name != "" {
+			return name
+		}
+	}
+	return "Unknown"
+}
+
+// OverseerrRequests maps external IDs ("tvdb:123", "tmdb:123") to the names of the users
+// who requested them, plus the approved requests that are not available yet
+type OverseerrRequests struct {
+	Requesters map[string][]string
+	Pending    []overseerrRequest
+}
+
+// overseerrConfigured reports whether an Overseerr/Jellyseerr server is configured
+func overseerrConfigured(cfg *Config) bool {
+	return cfg.OverseerrURL != "" && cfg.OverseerrAPIKey != ""
+}
+
+// newOverseerrRequest builds an authenticated GET request against an Overseerr/Jellyseerr server
+func newOverseerrRequest(ctx context.Context, baseURL, apiKey, path string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(baseURL, "/")+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Api-Key", apiKey)
+	req.Header.Set("Accept", "application/json")
+	return req, nil
+}
+
+// overseerrGet performs an authenticated GET and decodes the JSON response into v
+func overseerrGet(ctx context.Context, cfg *Config, path string, v interface{}) error {
+	req, err := newOverseerrRequest(ctx, cfg.OverseerrURL, cfg.OverseerrAPIKey, path)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API request failed with status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// fetchOverseerrRequests fetches every request from Overseerr/Jellyseerr (cached for 5 minutes)
+func fetchOverseerrRequests(ctx context.Context, cfg *Config) (*OverseerrRequests, error) {
+	if !overseerrConfigured(cfg) {
+		return nil, nil
+	}
+
+	// Check cache first
+	cacheKey := getCacheKey("overseerr_requests", cfg.OverseerrURL)
+	if cached, found := apiCache.Get(cacheKey); found {
+		log.Printf("📦 Using cached Overseerr requests")
+		return cached.(*OverseerrRequests), nil
+	}
+
+	requests := &OverseerrRequests{Requesters: make(map[string][]string)}
+	total := 0
+	const pageSize = 100
+	for skip := 0; ; skip += pageSize {
+		var result struct {
+			PageInfo struct {
+				Results int `json:"results"`
+			} `json:"pageInfo"`
+			Results []overseerrRequest `json:"results"`
+		}
+		path := fmt.Sprintf("/api/v1/request?take=%d&skip=%d&filter=all&sort=added", pageSize, skip)
+		if err := overseerrGet(ctx, cfg, path, &result); err != nil {
+			return nil, err
+		}
+
+		total += len(result.Results)
+		for _, r := range result.Results {
+			name := r.requesterName()
+			if r.Type == "tv" && r.Media.TvdbID > 0 {
+				key := fmt.Sprintf("tvdb:%d", r.Media.TvdbID)
+				requests.Requesters[key] = mergeInstanceNames(requests.Requesters[key], []string{name})
+			}
+			if r.Type == "movie" && r.Media.TmdbID > 0 {
+				key := fmt.Sprintf("tmdb:%d", r.Media.TmdbID)
+				requests.Requesters[key] = mergeInstanceNames(requests.Requesters[key], []string{name})
+			}
+			if r.Status == overseerrRequestApproved && r.Media.Status != overseerrMediaAvailable {
+				requests.Pending = append(requests.Pending, r)
+			}
+		}
+
+		if len(result.Results) < pageSize || skip+pageSize >= result.PageInfo.Results {
+			break
+		}
+	}
+
+	// Cache for 5 minutes
+	apiCache.Set(cacheKey, requests, cacheTTL)
+	log.Printf("🙋 Found %d requests for %d titles in Overseerr (%d pending)", total, len(requests.Requesters), len(requests.Pending))
+	return requests, nil
+}
+
+// seriesRequesters returns who requested a series, or nil if nobody did
+func (r *OverseerrRequests) seriesRequesters(tvdbID int) []string {
+	if r == nil || tvdbID <= 0 {
+		return nil
+	}
+	return r.Requesters[fmt.Sprintf("tvdb:%d", tvdbID)]
+}
+
+// movieRequesters returns who requested a movie, or nil if nobody did
+func (r *OverseerrRequests) movieRequesters(tmdbID int) []string {
+	if r == nil || tmdbID <= 0 {
+		return nil
+	}
+	return r.Requesters[fmt.Sprintf("tmdb:%d", tmdbID)]
+}
+
+// fetchPendingRequests resolves titles and posters for the approved requests that are not available yet.
+// Requests only carry IDs, so each one needs a details lookup; the list is capped at limit.
+func fetchPendingRequests(ctx context.Context, cfg *Config, requests *OverseerrRequests, limit int) []PendingRequest {
+	if requests == nil {
+		return nil
+	}
+
+	pending := []PendingRequest{}
+	for _, r := range requests.Pending {
+		if len(pending) >= limit {
+			break
+		}
+		if r.Media.TmdbID <= 0 {
+			continue
+		}
+
+		var details struct {
+			Title        string `json:"title"` // Movies
+			Name         string `json:"name"`  // Series
+			ReleaseDate  string `json:"releaseDate"`
+			FirstAirDate string `json:"firstAirDate"`
+			PosterPath   string `json:"posterPath"`
+		}
+		path := fmt.Sprintf("/api/v1/movie/%d", r.Media.TmdbID)
+		if r.Type == "tv" {
+			path = fmt.Sprintf("/api/v1/tv/%d", r.Media.TmdbID)
+		}
+
+		if err := overseerrGet(ctx, cfg, path, &details); err != nil {
+			log.Printf("⚠️  Failed to fetch details for request %d: %v", r.ID, err)
+			continue
+		}
+
+		item := PendingRequest{
+			Title:       details.Title,
+			Type:        "Movie",
+			RequestedBy: r.requesterName(),
+			RequestedAt: r.CreatedAt.Format("2006-01-02"),
+			Status:      "Approved",
+		}
+		date := details.ReleaseDate
+		if r.Type == "tv" {
+			item.Title = details.Name
+			item.Type = "Series"
+			date = details.FirstAirDate
+		}
+		if len(date) >= 4 {
+			fmt.Sscanf(date[:4], "%d", &item.Year)
+		}
+		if details.PosterPath != "" {
+			item.PosterURL = "https://image.tmdb.org/t/p/w300" + details.PosterPath
+		}
+		switch r.Media.Status {
+		case overseerrMediaProcessing:
+			item.Status = "Processing"
+		case overseerrMediaPartiallyAvailable:
+			item.Status = "Partially available"
+		}
+
+		pending = append(pending, item)
+	}
+	return pending
+}
+
+// linkRequestedSeries records who requested each series group
+func linkRequestedSeries(groups []SeriesGroup, requests *OverseerrRequests) []SeriesGroup {
+	if requests == nil {
+		return groups
+	}
+	for i := range groups {
+		groups[i].RequestedBy = requests.seriesRequesters(groups[i].TvdbID)
+	}
+	return groups
+}
@@ -0,0 +1,398 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// PlexLibrary maps external IDs ("imdb:tt123", "tvdb:123", "tmdb:123") to Plex rating keys
+// so items can be checked for membership and linked to in the Plex web app
+type PlexLibrary struct {
+	MachineID string
+	Shows     map[string]string
+	Movies    map[string]string
+}
+
+// plexMetadata is the subset of a Plex metadata item we need
+type plexMetadata struct {
+	RatingKey            string `json:"ratingKey"`
+	Type                 string `json:"type"`
+	Title                string `json:"title"`
+	Year                 int    `json:"year"`
+	Summary              string `json:"summary"`
+	AddedAt              int64  `json:"addedAt"`
+	GUID                 string `json:"guid"` // Legacy agents: "com.plexapp.agents.imdb://tt123?lang=en"
+	GrandparentTitle     string `json:"grandparentTitle"`
+	GrandparentRatingKey string `json:"grandparentRatingKey"`
+	GUIDs                []struct {
+		ID string `json:"id"` // New agents: "imdb://tt123", "tmdb://123", "tvdb://123"
+	} `json:"Guid"`
+}
+
+// plexMediaContainer is the envelope of every Plex JSON response
+type plexMediaContainer struct {
+	MediaContainer struct {
+		MachineIdentifier string         `json:"machineIdentifier"`
+		Metadata          []plexMetadata `json:"Metadata"`
+		Directory         []struct {
+			Key   string `json:"key"`
+			Type  string `json:"type"` // "movie", "show", "artist", "photo"
+			Title string `json:"title"`
+		} `json:"Directory"`
+	} `json:"MediaContainer"`
+}
+
+// plexConfigured reports whether a Plex server is configured
+func plexConfigured(cfg *Config) bool {
+	return cfg.PlexURL != "" && cfg.PlexToken != ""
+}
+
+// newPlexRequest builds an authenticated GET request against a Plex server
+func newPlexRequest(ctx context.Context, baseURL, token, path string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(baseURL, "/")+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Plex-Token", token)
+	req.Header.Set("Accept", "application/json")
+	return req, nil
+}
+
+// plexGet performs an authenticated GET against the Plex server and decodes the JSON response
+func plexGet(ctx context.Context, baseURL, token, path string) (*plexMediaContainer, error) {
+	req, err := newPlexRequest(ctx, baseURL, token, path)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+	}
+
+	var container plexMediaContainer
+	if err := json.NewDecoder(resp.Body).Decode(&container); err != nil {
+		return nil, err
+	}
+	return &container, nil
+}
+
+// plexIDKeys converts the GUIDs of a Plex item into library lookup keys
+func plexIDKeys(item plexMetadata) []string {
+	var keys []string
+	guids := []string{item.GUID}
+	for _, g := range item.GUIDs {
+		guids = append(guids, g.ID)
+	}
+
+	for _, guid := range guids {
+		scheme, id, found := strings.Cut(guid, "://")
+		if !found {
+			continue
+		}
+		// Strip legacy agent suffixes ("/1/2?lang=en")
+		if i := strings.IndexAny(id, "/?"); i >= 0 {
+			id = id[:i]
+		}
+		if id == "" {
+			continue
+		}
+
+		switch {
+		case strings.HasSuffix(scheme, "imdb"):
+			keys = append(keys, "imdb:"+id)
+		case strings.HasSuffix(scheme, "tvdb"):
+			keys = append(keys, "tvdb:"+id)
+		case strings.HasSuffix(scheme, "tmdb"), strings.HasSuffix(scheme, "themoviedb"):
+			keys = append(keys, "tmdb:"+id)
+		}
+	}
+	return keys
+}
+
+// plexSections returns the keys of all movie and show library sections
+func plexSections(ctx context.Context, cfg *Config) (movieSections, showSections []string, err error) {
+	container, err := plexGet(ctx, cfg.PlexURL, cfg.PlexToken, "/library/sections")
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, dir := range container.MediaContainer.Directory {
+		switch dir.Type {
+		case "movie":
+			movieSections = append(movieSections, dir.Key)
+		case "show":
+			showSections = append(showSections, dir.Key)
+		}
+	}
+	return movieSections, showSections, nil
+}
+
+// getPlexLibrary fetches and caches the IDs of every movie and show on the Plex server
+func getPlexLibrary(ctx context.Context, cfg *Config) (*PlexLibrary, error) {
+	if !plexConfigured(cfg) {
+		return nil, nil
+	}
+
+	// Check cache first
+	cacheKey := getCacheKey("plex_library", cfg.PlexToken, cfg.PlexURL)
+	if cached, found := apiCache.Get(cacheKey); found {
+		return cached.(*PlexLibrary), nil
+	}
+
+	identity, err := plexGet(ctx, cfg.PlexURL, cfg.PlexToken, "/identity")
+	if err != nil {
+		return nil, err
+	}
+
+	movieSections, showSections, err := plexSections(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	library := &PlexLibrary{
+		MachineID: identity.MediaContainer.MachineIdentifier,
+		Shows:     make(map[string]string),
+		Movies:    make(map[string]string),
+	}
+
+	// type=1 is movies, type=2 is shows
+	for _, section := range movieSections {
+		container, err := plexGet(ctx, cfg.PlexURL, cfg.PlexToken, "/library/sections/"+section+"/all?type=1&includeGuids=1")
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range container.MediaContainer.Metadata {
+			for _, key := range plexIDKeys(item) {
+				library.Movies[key] = item.RatingKey
+			}
+		}
+	}
+	for _, section := range showSections {
+		container, err := plexGet(ctx, cfg.PlexURL, cfg.PlexToken, "/library/sections/"+section+"/all?type=2&includeGuids=1")
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range container.MediaContainer.Metadata {
+			for _, key := range plexIDKeys(item) {
+				library.Shows[key] = item.RatingKey
+			}
+		}
+	}
+
+	// Cache for 5 minutes
+	apiCache.Set(cacheKey, library, cacheTTL)
+	log.Printf("📚 Cached %d movie and %d show IDs from Plex", len(library.Movies), len(library.Shows))
+	return library, nil
+}
+
+// plexItemURL returns the app.plex.tv deep link for a rating key
+func plexItemURL(machineID, ratingKey string) string {
+	if machineID == "" || ratingKey == "" {
+		return ""
+	}
+	return fmt.Sprintf("https://app.plex.tv/desktop/#!/server/%s/details?key=%s",
+		machineID, url.QueryEscape("/library/metadata/"+ratingKey))
+}
+
+// showURL returns the Plex deep link for a show, or "" if it isn't on the server
+func (l *PlexLibrary) showURL(imdbID string, tvdbID int) string {
+	if l == nil {
+		return ""
+	}
+	if imdbID != "" {
+		if ratingKey, ok := l.Shows["imdb:"+imdbID]; ok {
+			return plexItemURL(l.MachineID, ratingKey)
+		}
+	}
+	if tvdbID > 0 {
+		if ratingKey, ok := l.Shows[fmt.Sprintf("tvdb:%d", tvdbID)]; ok {
+			return plexItemURL(l.MachineID, ratingKey)
+		}
+	}
+	return ""
+}
+
+// movieURL returns the Plex deep link for a movie, or "" if it isn't on the server
+func (l *PlexLibrary) movieURL(imdbID string, tmdbID int) string {
+	if l == nil {
+		return ""
+	}
+	if imdbID != "" {
+		if ratingKey, ok := l.Movies["imdb:"+imdbID]; ok {
+			return plexItemURL(l.MachineID, ratingKey)
+		}
+	}
+	if tmdbID > 0 {
+		if ratingKey, ok := l.Movies[fmt.Sprintf("tmdb:%d", tmdbID)]; ok {
+			return plexItemURL(l.MachineID, ratingKey)
+		}
+	}
+	return ""
+}
+
+// fetchPlexRecentlyAdded returns the movies and series added to Plex since the given time.
+// New episodes are grouped under their show so a season drop shows up as one entry.
+func fetchPlexRecentlyAdded(ctx context.Context, cfg *Config, library *PlexLibrary, since time.Time) ([]MediaServerItem, error) {
+	if !plexConfigured(cfg) {
+		return nil, nil
+	}
+
+	// Check cache first
+	cacheKey := getCacheKey("plex_recently_added", cfg.PlexURL, since.Unix())
+	if cached, found := apiCache.Get(cacheKey); found {
+		log.Printf("📦 Using cached Plex recently added")
+		return cached.([]MediaServerItem), nil
+	}
+
+	movieSections, showSections, err := plexSections(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	machineID := ""
+	if library != nil {
+		machineID = library.MachineID
+	}
+
+	// Newest first, filtered server-side (type=1 is movies, type=4 is episodes)
+	filter := fmt.Sprintf("&sort=addedAt:desc&addedAt>>=%d&includeGuids=1", since.Unix())
+
+	type addedItem struct {
+		item    MediaServerItem
+		addedAt int64
+	}
+	var added []addedItem
+
+	for _, section := range movieSections {
+		container, err := plexGet(ctx, cfg.PlexURL, cfg.PlexToken, "/library/sections/"+section+"/all?type=1"+filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range container.MediaContainer.Metadata {
+			imdbID := ""
+			for _, key := range plexIDKeys(m) {
+				if strings.HasPrefix(key, "imdb:") {
+					imdbID = strings.TrimPrefix(key, "imdb:")
+				}
+			}
+			added = append(added, addedItem{
+				item: MediaServerItem{
+					Title:    m.Title,
+					Type:     "Movie",
+					Year:     m.Year,
+					Overview: m.Summary,
+					IMDBID:   imdbID,
+					URL:      plexItemURL(machineID, m.RatingKey),
+				},
+				addedAt: m.AddedAt,
+			})
+		}
+	}
+
+	for _, section := range showSections {
+		container, err := plexGet(ctx, cfg.PlexURL, cfg.PlexToken, "/library/sections/"+section+"/all?type=4"+filter)
+		if err != nil {
+			return nil, err
+		}
+		showIndex := make(map[string]int)
+		for _, ep := range container.MediaContainer.Metadata {
+			key := ep.GrandparentRatingKey
+			if key == "" {
+				key = ep.GrandparentTitle
+			}
+			if idx, exists := showIndex[key]; exists {
+				added[idx].item.Episodes++
+				continue
+			}
+			showIndex[key] = len(added)
+			added = append(added, addedItem{
+				item: MediaServerItem{
+					Title:    ep.GrandparentTitle,
+					Type:     "Series",
+					Episodes: 1,
+					URL:      plexItemURL(machineID, ep.GrandparentRatingKey),
+				},
+				addedAt: ep.AddedAt,
+			})
+		}
+	}
+
+	// Merge sections into a single newest-first list
+	sort.SliceStable(added, func(i, j int) bool {
+		return added[i].addedAt > added[j].addedAt
+	})
+
+	limit := cfg.ServerRecentlyAddedLimit
+	if limit <= 0 {
+		limit = DefaultServerRecentlyAddedLimit
+	}
+	items := make([]MediaServerItem, 0, limit)
+	for _, a := range added {
+		if len(items) >= limit {
+			break
+		}
+		items = append(items, a.item)
+	}
+
+	// Store in cache
+	apiCache.Set(cacheKey, items, cacheTTL)
+	return items, nil
+}
+
+// linkPlexSeries sets the Plex deep link on every series group that is on the Plex server
+func linkPlexSeries(groups []SeriesGroup, library *PlexLibrary) []SeriesGroup {
+	if library == nil {
+		return groups
+	}
+	for i := range groups {
+		groups[i].PlexURL = library.showURL(groups[i].IMDBID, groups[i].TvdbID)
+	}
+	return groups
+}
+
+// mergeServerItems appends the Plex items to the Jellyfin/Emby items, skipping titles
+// already reported by the other server, and caps the result at limit
+func mergeServerItems(items, plexItems []MediaServerItem, limit int) []MediaServerItem {
+	if len(plexItems) == 0 {
+		return items
+	}
+	if limit <= 0 {
+		limit = DefaultServerRecentlyAddedLimit
+	}
+
+	merged := make([]MediaServerItem, 0, len(items)+len(plexItems))
+	seen := make(map[string]int)
+	for _, item := range items {
+		seen[item.Type+":"+strings.ToLower(item.Title)] = len(merged)
+		merged = append(merged, item)
+	}
+	for _, item := range plexItems {
+		key := item.Type + ":" + strings.ToLower(item.Title)
+		if idx, exists := seen[key]; exists {
+			// Keep the Jellyfin/Emby entry but link it to Plex too
+			if merged[idx].URL == "" {
+				merged[idx].URL = item.URL
+			}
+			continue
+		}
+		seen[key] = len(merged)
+		merged = append(merged, item)
+	}
+
+	if len(merged) > limit {
+		merged = merged[:limit]
+	}
+	return merged
+}
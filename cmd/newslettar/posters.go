@@ -0,0 +1,291 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"image"
+	"image/draw"
+	_ "image/gif" // Register decoders for image.Decode
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// With EMAIL_INLINE_POSTERS=true the email carries its posters as inline multipart/related parts
+// referenced by cid: instead of remote URLs, which clients block by default and which can't be
+// loaded from outside the LAN for Sonarr/Radarr media covers. Posters are downloaded during the run,
+// cropped and scaled to the size the template shows them at, and cached in posterCacheDir.
+
+const (
+	posterCacheDir     = "poster_cache"
+	posterCacheTTL     = 30 * 24 * time.Hour // Artwork rarely changes, same as the TMDB lookups
+	posterConcurrency  = 5
+	posterScale        = 2 // Pixels per CSS pixel, keeps posters sharp on high-density screens
+	posterJPEGQuality  = 85
+	posterMaxDownload  = 10 << 20
+	posterMaxPixels    = 25_000_000 // Decoded size limit, well above TMDB originals (2000x3000)
+	posterContentIDFmt = "poster-%s@newslettar"
+)
+
+// posterImgPattern matches the cover images of email.html; the class gives the displayed size
+var posterImgPattern = regexp.MustCompile(`<img src="([^"]+)"([^>]*) class="(poster|movie-poster|album-cover|book-cover)"`)
+
+// posterSizes are the CSS sizes of the cover classes in email.html
+var posterSizes = map[string]image.Point{
+	"poster":       {60, 90},
+	"movie-poster": {80, 120},
+	"album-cover":  {80, 80},
+	"book-cover":   {70, 105},
+}
+
+// arrImageURL resolves a relative media cover path such as "/MediaCover/12/poster.jpg", which
+// the Sonarr/Radarr calendars return, against the instance that served it. The path doesn't say
+// which instance that was and IDs repeat across instances, so with several instances of a kind
+// only a path under an instance's URL base is resolved, rather than risk the wrong poster.
+// The resolved URL stays in the email part, PosterURL keeps the path for every other consumer.
+func arrImageURL(cfg *Config, class, imagePath string) string {
+	var instances []ArrInstance
+	switch class {
+	case "poster":
+		instances = configuredInstances(cfg.SonarrInstances)
+	case "movie-poster":
+		instances = configuredInstances(cfg.RadarrInstances)
+	}
+
+	for _, inst := range instances {
+		u, err := url.Parse(inst.URL)
+		if err != nil {
+			continue
+		}
+		if base := strings.TrimSuffix(u.Path, "/"); base != "" && strings.HasPrefix(imagePath, base+"/") {
+			return u.Scheme + "://" + u.Host + imagePath
+		}
+	}
+	if len(instances) == 1 {
+		return strings.TrimSuffix(instances[0].URL, "/") + imagePath
+	}
+	return ""
+}
+
+// posterRequest is one image to embed: its source and the largest size it is shown at
+type posterRequest struct {
+	URL  string
+	Size image.Point
+}
+
+// inlinePosters replaces the poster URLs of the email HTML with cid: references and returns
+// the images to attach. Posters that can't be loaded keep their remote URL.
+func inlinePosters(ctx context.Context, cfg *Config, htmlBody string) (string, []emailAttachment) {
+	requests := make(map[string]*posterRequest)
+	var order []string
+	for _, m := range posterImgPattern.FindAllStringSubmatch(htmlBody, -1) {
+		src, size := m[1], posterSizes[m[3]]
+		if req, ok := requests[src]; ok {
+			req.Size.X = max(req.Size.X, size.X)
+			req.Size.Y = max(req.Size.Y, size.Y)
+			continue
+		}
+		u := html.UnescapeString(src)
+		if strings.HasPrefix(u, "/") {
+			u = arrImageURL(cfg, m[3], u)
+		}
+		if strings.HasPrefix(u, "http://") || strings.HasPrefix(u, "https://") {
+			requests[src] = &posterRequest{URL: u, Size: size}
+			order = append(order, src)
+		}
+	}
+	if len(order) == 0 {
+		return htmlBody, nil
+	}
+
+	images := make([][]byte, len(order))
+	sem := make(chan struct{}, posterConcurrency)
+	var wg sync.WaitGroup
+	for i, src := range order {
+		wg.Add(1)
+		go func(i int, req *posterRequest) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			data, err := loadPoster(ctx, cfg, req.URL, req.Size.Mul(posterScale))
+			if err != nil {
+				log.Printf("⚠️  Could not embed poster %s: %v", req.URL, err)
+				return
+			}
+			images[i] = data
+		}(i, requests[src])
+	}
+	wg.Wait()
+
+	var attachments []emailAttachment
+	cids := make(map[string]string)
+	for i, src := range order {
+		if images[i] == nil {
+			continue
+		}
+		cid := fmt.Sprintf(posterContentIDFmt, posterCacheKey(requests[src].URL, requests[src].Size)[:16])
+		cids[src] = cid
+		attachments = append(attachments, emailAttachment{
+			Filename:    fmt.Sprintf("poster-%d.jpg", len(attachments)+1),
+			ContentType: "image/jpeg",
+			ContentID:   cid,
+			Data:        images[i],
+		})
+	}
+
+	htmlBody = posterImgPattern.ReplaceAllStringFunc(htmlBody, func(tag string) string {
+		m := posterImgPattern.FindStringSubmatch(tag)
+		cid, ok := cids[m[1]]
+		if !ok {
+			return tag
+		}
+		return strings.Replace(tag, `src="`+m[1]+`"`, `src="cid:`+cid+`"`, 1)
+	})
+	log.Printf("🖼️  Embedded %d of %d posters", len(attachments), len(order))
+	return htmlBody, attachments
+}
+
+// posterCacheKey identifies a poster at a given display size
+func posterCacheKey(posterURL string, size image.Point) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%dx%d", posterURL, size.X, size.Y)))
+	return hex.EncodeToString(sum[:])
+}
+
+// loadPoster returns the poster as a JPEG of exactly size pixels, from the cache when it is fresh
+func loadPoster(ctx context.Context, cfg *Config, posterURL string, size image.Point) ([]byte, error) {
+	path := filepath.Join(posterCacheDir, posterCacheKey(posterURL, size)+".jpg")
+	if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) < posterCacheTTL {
+		if data, err := os.ReadFile(path); err == nil {
+			return data, nil
+		}
+	}
+
+	src, err := downloadPoster(ctx, cfg, posterURL)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, scaleCover(src, size), &jpeg.Options{Quality: posterJPEGQuality}); err != nil {
+		return nil, err
+	}
+
+	// A failed cache write only costs a download next time
+	if err := os.MkdirAll(posterCacheDir, 0755); err == nil {
+		if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+			log.Printf("⚠️  Could not cache poster: %v", err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// downloadPoster fetches and decodes an image. Sonarr/Radarr/Readarr media covers
+// are requested with the API key of the instance they belong to.
+func downloadPoster(ctx context.Context, cfg *Config, posterURL string) (image.Image, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", posterURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	instances := append(append(append([]ArrInstance{}, cfg.SonarrInstances...), cfg.RadarrInstances...), cfg.ReadarrInstances...)
+	instances = append(instances, ArrInstance{URL: cfg.LidarrURL, APIKey: cfg.LidarrAPIKey})
+	for _, inst := range configuredInstances(instances) {
+		if strings.HasPrefix(posterURL, strings.TrimSuffix(inst.URL, "/")+"/") {
+			req.Header.Set("X-Api-Key", inst.APIKey)
+			break
+		}
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, posterMaxDownload+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > posterMaxDownload {
+		return nil, fmt.Errorf("image larger than %d MB", posterMaxDownload>>20)
+	}
+
+	// A small compressed file can declare huge dimensions, check them before decoding
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, fmt.Errorf("empty image")
+	}
+	if config.Width > posterMaxPixels/config.Height {
+		return nil, fmt.Errorf("image too large (%dx%d)", config.Width, config.Height)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}
+
+// scaleCover crops src to the aspect ratio of size (centered, like CSS object-fit: cover)
+// and scales it to size by averaging the source pixels that cover each target pixel
+func scaleCover(src image.Image, size image.Point) *image.RGBA {
+	b := src.Bounds()
+	crop := b
+	if b.Dx()*size.Y > b.Dy()*size.X {
+		w := max(b.Dy()*size.X/size.Y, 1)
+		crop.Min.X += (b.Dx() - w) / 2
+		crop.Max.X = crop.Min.X + w
+	} else {
+		h := max(b.Dx()*size.Y/size.X, 1)
+		crop.Min.Y += (b.Dy() - h) / 2
+		crop.Max.Y = crop.Min.Y + h
+	}
+
+	// JPEG has no alpha channel, transparent covers go on white like the light theme
+	rgba := image.NewRGBA(image.Rect(0, 0, crop.Dx(), crop.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(rgba, rgba.Bounds(), src, crop.Min, draw.Over)
+
+	dst := image.NewRGBA(image.Rect(0, 0, size.X, size.Y))
+	for y := 0; y < size.Y; y++ {
+		y0 := y * crop.Dy() / size.Y
+		y1 := max((y+1)*crop.Dy()/size.Y, y0+1)
+		for x := 0; x < size.X; x++ {
+			x0 := x * crop.Dx() / size.X
+			x1 := max((x+1)*crop.Dx()/size.X, x0+1)
+
+			var r, g, bl, a, n uint32
+			for sy := y0; sy < y1; sy++ {
+				row := rgba.Pix[sy*rgba.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					r += uint32(p[0])
+					g += uint32(p[1])
+					bl += uint32(p[2])
+					a += uint32(p[3])
+					n++
+				}
+			}
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(bl / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
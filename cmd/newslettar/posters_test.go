@@ -0,0 +1,79 @@
+package main
+
+import "testing"
+
+func TestArrImageURL(t *testing.T) {
+	sonarr := ArrInstance{Name: "Sonarr", URL: "http://sonarr:8989", APIKey: "key"}
+	sonarrBase := ArrInstance{Name: "Sonarr", URL: "http://media.lan/sonarr/", APIKey: "key"}
+	sonarr4KBase := ArrInstance{Name: "Sonarr 4K", URL: "http://media.lan/sonarr-4k", APIKey: "key"}
+	radarr := ArrInstance{Name: "Radarr", URL: "https://radarr.lan", APIKey: "key"}
+
+	tests := []struct {
+		name      string
+		cfg       Config
+		class     string
+		imagePath string
+		want      string
+	}{
+		{
+			name:      "single instance",
+			cfg:       Config{SonarrInstances: []ArrInstance{sonarr}},
+			class:     "poster",
+			imagePath: "/MediaCover/12/poster.jpg",
+			want:      "http://sonarr:8989/MediaCover/12/poster.jpg",
+		},
+		{
+			name:      "single instance with a URL base",
+			cfg:       Config{SonarrInstances: []ArrInstance{sonarrBase}},
+			class:     "poster",
+			imagePath: "/sonarr/MediaCover/12/poster.jpg",
+			want:      "http://media.lan/sonarr/MediaCover/12/poster.jpg",
+		},
+		{
+			name:      "movie posters come from Radarr",
+			cfg:       Config{SonarrInstances: []ArrInstance{sonarr}, RadarrInstances: []ArrInstance{radarr}},
+			class:     "movie-poster",
+			imagePath: "/MediaCover/7/poster.jpg",
+			want:      "https://radarr.lan/MediaCover/7/poster.jpg",
+		},
+		{
+			name:      "instances told apart by URL base",
+			cfg:       Config{SonarrInstances: []ArrInstance{sonarrBase, sonarr4KBase}},
+			class:     "poster",
+			imagePath: "/sonarr-4k/MediaCover/12/poster.jpg",
+			want:      "http://media.lan/sonarr-4k/MediaCover/12/poster.jpg",
+		},
+		{
+			name:      "ambiguous between instances without URL base",
+			cfg:       Config{SonarrInstances: []ArrInstance{sonarr, {Name: "Anime", URL: "http://anime:8989", APIKey: "key"}}},
+			class:     "poster",
+			imagePath: "/MediaCover/12/poster.jpg",
+		},
+		{
+			name:      "unconfigured instances are ignored",
+			cfg:       Config{SonarrInstances: []ArrInstance{sonarr, {Name: "Sonarr 2", URL: "http://other:8989"}}},
+			class:     "poster",
+			imagePath: "/MediaCover/12/poster.jpg",
+			want:      "http://sonarr:8989/MediaCover/12/poster.jpg",
+		},
+		{
+			name:      "no instance",
+			cfg:       Config{},
+			class:     "movie-poster",
+			imagePath: "/MediaCover/7/poster.jpg",
+		},
+		{
+			name:      "other cover classes",
+			cfg:       Config{SonarrInstances: []ArrInstance{sonarr}},
+			class:     "album-cover",
+			imagePath: "/MediaCover/3/cover.jpg",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := arrImageURL(&tt.cfg, tt.class, tt.imagePath); got != tt.want {
+				t.Errorf("arrImageURL(%q, %q) = %q, want %q", tt.class, tt.imagePath, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Matrix, ntfy and Gotify only get a compact summary of the newsletter
+// ("5 episodes, 2 movies arriving this week") with a link to the web archive copy.
+
+// pushRequest sends a JSON payload and fails on any non-2xx response
+func pushRequest(ctx context.Context, method, reqURL string, headers map[string]string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return pushJSON(ctx, method, reqURL, headers, body)
+}
+
+// pushJSON sends an already encoded JSON body and fails on any non-2xx response
+func pushJSON(ctx context.Context, method, reqURL string, headers map[string]string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return nil
+}
+
+// matrixNotifier posts the summary to a Matrix room as the configured (bot) user
+type matrixNotifier struct {
+	cfg *Config
+}
+
+func (n matrixNotifier) Name() string { return "matrix" }
+
+func (n matrixNotifier) Send(ctx context.Context, issue *NewsletterIssue) error {
+	return n.push(ctx, issue.Subject, newsletterSummary(issue.Data, issue.Period), issue.ArchiveURL)
+}
+
+func (n matrixNotifier) push(ctx context.Context, title, message, link string) error {
+	body := title + "\n" + message
+	formatted := "<strong>" + template.HTMLEscapeString(title) + "</strong><br>" + template.HTMLEscapeString(message)
+	if link != "" {
+		body += "\n" + link
+		formatted += `<br><a href="` + template.HTMLEscapeString(link) + `">Read the full newsletter</a>`
+	}
+
+	// The transaction ID makes retries of the same request idempotent
+	txnID := fmt.Sprintf("newslettar-%d", time.Now().UnixNano())
+	reqURL := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s",
+		n.cfg.MatrixHomeserverURL, url.PathEscape(n.cfg.MatrixRoomID), txnID)
+	return pushRequest(ctx, "PUT", reqURL, map[string]string{"Authorization": "Bearer " + n.cfg.MatrixAccessToken}, map[string]string{
+		"msgtype":        "m.text",
+		"body":           body,
+		"format":         "org.matrix.custom.html",
+		"formatted_body": formatted,
+	})
+}
+
+// ntfyNotifier publishes the summary to an ntfy topic; tapping the notification opens the archive copy
+type ntfyNotifier struct {
+	cfg *Config
+}
+
+func (n ntfyNotifier) Name() string { return "ntfy" }
+
+func (n ntfyNotifier) Send(ctx context.Context, issue *NewsletterIssue) error {
+	return n.push(ctx, issue.Subject, newsletterSummary(issue.Data, issue.Period), issue.ArchiveURL)
+}
+
+func (n ntfyNotifier) push(ctx context.Context, title, message, link string) error {
+	payload := map[string]interface{}{
+		"topic":   n.cfg.NtfyTopic,
+		"title":   title,
+		"message": message,
+		"tags":    []string{"tv"},
+	}
+	if link != "" {
+		payload["click"] = link
+		payload["actions"] = []map[string]string{{"action": "view", "label": "Read newsletter", "url": link}}
+	}
+	headers := map[string]string{}
+	if n.cfg.NtfyToken != "" {
+		headers["Authorization"] = "Bearer " + n.cfg.NtfyToken
+	}
+	return pushRequest(ctx, "POST", n.cfg.NtfyURL, headers, payload)
+}
+
+// gotifyNotifier posts the summary as a Gotify application message
+type gotifyNotifier struct {
+	cfg *Config
+}
+
+func (n gotifyNotifier) Name() string { return "gotify" }
+
+func (n gotifyNotifier) Send(ctx context.Context, issue *NewsletterIssue) error {
+	return n.push(ctx, issue.Subject, newsletterSummary(issue.Data, issue.Period), issue.ArchiveURL)
+}
+
+func (n gotifyNotifier) push(ctx context.Context, title, message, link string) error {
+	extras := map[string]interface{}{}
+	if link != "" {
+		message += "\n\n[Read the full newsletter](" + link + ")"
+		extras["client::display"] = map[string]string{"contentType": "text/markdown"}
+		extras["client::notification"] = map[string]interface{}{"click": map[string]string{"url": link}}
+	}
+	return pushRequest(ctx, "POST", n.cfg.GotifyURL+"/message", map[string]string{"X-Gotify-Key": n.cfg.GotifyToken}, map[string]interface{}{
+		"title":    title,
+		"message":  message,
+		"priority": 5,
+		"extras":   extras,
+	})
+}
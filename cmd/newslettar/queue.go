@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Sonarr and Radarr share the /api/v3/queue endpoint; only the embedded series/episode/movie differ
+
+// arrQueueRecord is the subset of a Sonarr/Radarr QueueResource we need
+type arrQueueRecord struct {
+	Status                  string  `json:"status"` // "downloading", "queued", "paused", "completed", ...
+	TrackedDownloadState    string  `json:"trackedDownloadState"`
+	Size                    float64 `json:"size"`
+	SizeLeft                float64 `json:"sizeleft"`
+	EstimatedCompletionTime string  `json:"estimatedCompletionTime"`
+	Series                  *struct {
+		Title  string     `json:"title"`
+		Images []arrImage `json:"images"`
+	} `json:"series"`
+	Episode *struct {
+		SeasonNumber  int    `json:"seasonNumber"`
+		EpisodeNumber int    `json:"episodeNumber"`
+		Title         string `json:"title"`
+	} `json:"episode"`
+	Movie *struct {
+		Title  string     `json:"title"`
+		Year   int        `json:"year"`
+		Images []arrImage `json:"images"`
+	} `json:"movie"`
+}
+
+type arrImage struct {
+	CoverType string `json:"coverType"`
+	URL       string `json:"url"`
+	RemoteURL string `json:"remoteUrl"`
+}
+
+// posterURL returns the poster of an image list, preferring the local URL like the calendar does
+func posterURL(images []arrImage) string {
+	for _, img := range images {
+		if img.CoverType == "poster" {
+			if img.URL != "" {
+				return img.URL
+			}
+			return img.RemoteURL
+		}
+	}
+	return ""
+}
+
+// toQueueItem maps a queue record to the template QueueItem
+func (r arrQueueRecord) toQueueItem(instanceName string) QueueItem {
+	item := QueueItem{
+		Status:    r.Status,
+		Instances: []string{instanceName},
+	}
+
+	switch {
+	case r.Series != nil:
+		item.Type = "Episode"
+		item.Title = r.Series.Title
+		item.PosterURL = posterURL(r.Series.Images)
+		if r.Episode != nil {
+			item.Subtitle = fmt.Sprintf("S%02dE%02d", r.Episode.SeasonNumber, r.Episode.EpisodeNumber)
+			if r.Episode.Title != "" {
+				item.Subtitle += " • " + r.Episode.Title
+			}
+		}
+	case r.Movie != nil:
+		item.Type = "Movie"
+		item.Title = r.Movie.Title
+		item.Year = r.Movie.Year
+		item.PosterURL = posterURL(r.Movie.Images)
+	}
+
+	if r.Size > 0 {
+		item.Progress = int((r.Size - r.SizeLeft) / r.Size * 100)
+	}
+	if r.TrackedDownloadState == "importPending" || r.TrackedDownloadState == "importing" {
+		item.Progress = 100
+		item.Status = "importing"
+	}
+
+	if t, err := time.Parse(time.RFC3339, r.EstimatedCompletionTime); err == nil && r.Status == "downloading" {
+		item.ETA = formatWatchTime(int(time.Until(t).Seconds()))
+	}
+
+	return item
+}
+
+func fetchQueueWithRetry(ctx context.Context, cfg *Config, inst ArrInstance, include string, maxRetries int) ([]QueueItem, error) {
+	return retryWithBackoff(func() ([]QueueItem, error) {
+		return fetchQueue(ctx, cfg, inst, include)
+	}, inst.Name+" queue", maxRetries)
+}
+
+// fetchQueue returns the items currently in a Sonarr or Radarr download queue.
+// include is "includeSeries=true&includeEpisode=true" for Sonarr and "includeMovie=true" for Radarr.
+func fetchQueue(ctx context.Context, cfg *Config, inst ArrInstance, include string) ([]QueueItem, error) {
+	if !inst.configured() {
+		return nil, fmt.Errorf("%s not configured", inst.Name)
+	}
+
+	// Check cache first
+	cacheKey := getCacheKey("arr_queue", inst.URL)
+	if cached, found := apiCache.Get(cacheKey); found {
+		log.Printf("📦 Using cached %s queue", inst.Name)
+		return cached.([]QueueItem), nil
+	}
+
+	url := fmt.Sprintf("%s/api/v3/queue?page=1&pageSize=%d&sortKey=timeleft&sortDirection=ascending&%s", inst.URL, cfg.APIPageSize, include)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Api-Key", inst.APIKey)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Records []arrQueueRecord `json:"records"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	items := make([]QueueItem, 0, len(result.Records))
+	for _, record := range result.Records {
+		item := record.toQueueItem(inst.Name)
+		if item.Title == "" {
+			continue // Unknown download that Sonarr/Radarr couldn't match
+		}
+		items = append(items, item)
+	}
+
+	// Store in cache
+	apiCache.Set(cacheKey, items, cacheTTL)
+
+	return items, nil
+}
+
+// mergeQueueItems removes duplicates reported by several instances (or several releases),
+// keeps the most advanced one, and sorts the result by progress
+func mergeQueueItems(items []QueueItem, limit int) []QueueItem {
+	seen := make(map[string]int)
+	merged := []QueueItem{}
+	for _, item := range items {
+		key := strings.ToLower(item.Type + "|" + item.Title + "|" + item.Subtitle)
+		if i, ok := seen[key]; ok {
+			instances := mergeInstanceNames(merged[i].Instances, item.Instances)
+			if item.Progress > merged[i].Progress {
+				merged[i] = item
+			}
+			merged[i].Instances = instances
+			continue
+		}
+		seen[key] = len(merged)
+		merged = append(merged, item)
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Progress > merged[j].Progress
+	})
+	if len(merged) > limit {
+		merged = merged[:limit]
+	}
+	return merged
+}
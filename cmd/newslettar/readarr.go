@@ -0,0 +1,255 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+)
+
+// Readarr uses the same *arr API conventions as Sonarr/Radarr, but on /api/v1.
+// Ebooks and audiobooks are usually kept in separate Readarr instances, so Readarr
+// supports multiple named instances just like Sonarr and Radarr.
+
+// readarrBook is the subset of a Readarr BookResource we need
+type readarrBook struct {
+	ID            int    `json:"id"`
+	Title         string `json:"title"`
+	SeriesTitle   string `json:"seriesTitle"`
+	ForeignBookID string `json:"foreignBookId"`
+	ReleaseDate   string `json:"releaseDate"`
+	Overview      string `json:"overview"`
+	PageCount     int    `json:"pageCount"`
+	Monitored     bool   `json:"monitored"`
+	Images        []struct {
+		CoverType string `json:"coverType"`
+		URL       string `json:"url"`
+		RemoteURL string `json:"remoteUrl"`
+	} `json:"images"`
+	Links []struct {
+		URL  string `json:"url"`
+		Name string `json:"name"`
+	} `json:"links"`
+	Ratings struct {
+		Value float64 `json:"value"`
+	} `json:"ratings"`
+	Author *readarrAuthor `json:"author"`
+}
+
+type readarrAuthor struct {
+	AuthorName string `json:"authorName"`
+}
+
+// toBook maps a Readarr book to the template Book struct
+func (b readarrBook) toBook(author *readarrAuthor, instanceName string) Book {
+	coverURL := ""
+	for _, img := range b.Images {
+		if img.CoverType == "cover" {
+			if img.RemoteURL != "" {
+				coverURL = img.RemoteURL
+			} else {
+				coverURL = img.URL
+			}
+			break
+		}
+	}
+
+	link := ""
+	if len(b.Links) > 0 {
+		link = b.Links[0].URL
+	}
+
+	if author == nil {
+		author = b.Author
+	}
+	authorName := ""
+	if author != nil {
+		authorName = author.AuthorName
+	}
+
+	releaseDate := ""
+	if t, err := time.Parse(time.RFC3339, b.ReleaseDate); err == nil {
+		releaseDate = t.Format("2006-01-02")
+	}
+
+	return Book{
+		Title:         b.Title,
+		Author:        authorName,
+		SeriesTitle:   b.SeriesTitle,
+		ReleaseDate:   releaseDate,
+		CoverURL:      coverURL,
+		Overview:      b.Overview,
+		PageCount:     b.PageCount,
+		Rating:        b.Ratings.Value,
+		URL:           link,
+		ForeignBookID: b.ForeignBookID,
+		Monitored:     b.Monitored,
+		Instances:     []string{instanceName},
+	}
+}
+
+// Retry wrappers for Readarr API calls
+func fetchReadarrHistoryWithRetry(ctx context.Context, cfg *Config, inst ArrInstance, since time.Time, maxRetries int) ([]Book, error) {
+	return retryWithBackoff(func() ([]Book, error) {
+		return fetchReadarrHistory(ctx, cfg, inst, since)
+	}, inst.Name+" history", maxRetries)
+}
+
+func fetchReadarrCalendarWithRetry(ctx context.Context, cfg *Config, inst ArrInstance, start, end time.Time, maxRetries int) ([]Book, error) {
+	return retryWithBackoff(func() ([]Book, error) {
+		return fetchReadarrCalendar(ctx, cfg, inst, start, end)
+	}, inst.Name+" calendar", maxRetries)
+}
+
+// fetchReadarrHistory returns the books imported since the given time.
+// Readarr records one import event per file (audiobooks often have many), so books are deduplicated by ID.
+func fetchReadarrHistory(ctx context.Context, cfg *Config, inst ArrInstance, since time.Time) ([]Book, error) {
+	if !inst.configured() {
+		return nil, fmt.Errorf("%s not configured", inst.Name)
+	}
+
+	// Check cache first
+	cacheKey := getCacheKey("readarr_history", inst.URL, since.Unix())
+	if cached, found := apiCache.Get(cacheKey); found {
+		log.Printf("📦 Using cached %s history", inst.Name)
+		return cached.([]Book), nil
+	}
+
+	books := []Book{}
+	seen := make(map[int]bool)
+	page := 1
+
+	for {
+		url := fmt.Sprintf("%s/api/v1/history?page=%d&pageSize=%d&sortKey=date&sortDirection=descending&includeBook=true&includeAuthor=true", inst.URL, page, cfg.APIPageSize)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("X-Api-Key", inst.APIKey)
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != 200 {
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, fmt.Errorf("HTTP %d (failed to read error body: %v)", resp.StatusCode, err)
+			}
+			return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+		}
+
+		var result struct {
+			Page         int `json:"page"`
+			PageSize     int `json:"pageSize"`
+			TotalRecords int `json:"totalRecords"`
+			Records      []struct {
+				BookID    int            `json:"bookId"`
+				Date      time.Time      `json:"date"`
+				EventType string         `json:"eventType"`
+				Book      readarrBook    `json:"book"`
+				Author    *readarrAuthor `json:"author"`
+			} `json:"records"`
+		}
+
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		resp.Body.Close()
+
+		// Track if we found any records older than our date range
+		foundOldRecords := false
+
+		for _, record := range result.Records {
+			// Only include import events
+			if record.EventType != "bookFileImported" && record.EventType != "downloadImported" {
+				continue
+			}
+
+			// Filter by date - if record is before our range, mark it but continue
+			if record.Date.Before(since) {
+				foundOldRecords = true
+				continue
+			}
+
+			if seen[record.BookID] {
+				continue
+			}
+			seen[record.BookID] = true
+
+			book := record.Book.toBook(record.Author, inst.Name)
+			book.Downloaded = true
+			books = append(books, book)
+		}
+
+		// Stop pagination if:
+		// 1. We've fetched all records, OR
+		// 2. We found records older than our date range (no need to fetch older pages)
+		if len(result.Records) == 0 || page*result.PageSize >= result.TotalRecords || foundOldRecords {
+			break
+		}
+
+		page++
+		log.Printf("📄 Fetching %s history page %d...", inst.Name, page)
+	}
+
+	// Store in cache
+	apiCache.Set(cacheKey, books, cacheTTL)
+
+	return books, nil
+}
+
+// fetchReadarrCalendar returns the books releasing between start and end
+func fetchReadarrCalendar(ctx context.Context, cfg *Config, inst ArrInstance, start, end time.Time) ([]Book, error) {
+	if !inst.configured() {
+		return nil, fmt.Errorf("%s not configured", inst.Name)
+	}
+
+	// Check cache first
+	cacheKey := getCacheKey("readarr_calendar", inst.URL, start.Unix(), end.Unix())
+	if cached, found := apiCache.Get(cacheKey); found {
+		log.Printf("📦 Using cached %s calendar", inst.Name)
+		return cached.([]Book), nil
+	}
+
+	url := fmt.Sprintf("%s/api/v1/calendar?unmonitored=true&includeAuthor=true&start=%s&end=%s",
+		inst.URL, start.Format("2006-01-02"), end.Format("2006-01-02"))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Api-Key", inst.APIKey)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+	}
+
+	// Stream-decode JSON to save memory
+	var calendar []readarrBook
+	if err := json.NewDecoder(resp.Body).Decode(&calendar); err != nil {
+		return nil, err
+	}
+
+	books := make([]Book, 0, len(calendar))
+	for _, entry := range calendar {
+		books = append(books, entry.toBook(nil, inst.Name))
+	}
+
+	// Store in cache
+	apiCache.Set(cacheKey, books, cacheTTL)
+
+	return books, nil
+}
@@ -39,6 +39,18 @@ func setupScheduler(cfg *Config) {
 		return
 	}
 
+	// Admin health digest runs on its own schedule, only when admin recipients are set
+	if len(cfg.AdminEmails) > 0 {
+		adminCronExpr := convertToCronExpression(cfg.AdminScheduleDay, cfg.AdminScheduleTime, cfg.AdminScheduleType, 0)
+		log.Printf("📅 Setting up admin digest (%s): %s (cron: %s)", cfg.AdminScheduleType, cfg.AdminScheduleTime, adminCronExpr)
+		if _, err := scheduler.AddFunc(adminCronExpr, func() {
+			log.Println("⏰ Scheduled admin digest triggered")
+			runAdminDigest()
+		}); err != nil {
+			log.Printf("⚠️  Failed to schedule admin digest: %v", err)
+		}
+	}
+
 	scheduler.Start()
 	log.Println("✅ Internal scheduler started")
 }
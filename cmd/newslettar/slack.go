@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Slack renders the newsletter as Block Kit messages for an incoming webhook: a header,
+// then one section per series group or movie with its poster as image accessory.
+// Mattermost accepts the same webhook payloads and shows the plain-text fallback.
+
+const (
+	slackMaxBlocks      = 50   // Blocks per message
+	slackMaxHeader      = 150  // Characters in a header block
+	slackMaxSectionText = 3000 // Characters in a section block
+	slackMessageDelay   = time.Second
+)
+
+type slackText struct {
+	Type string `json:"type"` // "plain_text" or "mrkdwn"
+	Text string `json:"text"`
+}
+
+type slackAccessory struct {
+	Type     string `json:"type"` // "image"
+	ImageURL string `json:"image_url"`
+	AltText  string `json:"alt_text"`
+}
+
+type slackBlock struct {
+	Type      string          `json:"type"`
+	Text      *slackText      `json:"text,omitempty"`
+	Elements  []slackText     `json:"elements,omitempty"`
+	Accessory *slackAccessory `json:"accessory,omitempty"`
+}
+
+type slackMessage struct {
+	Text     string       `json:"text"` // Fallback for notifications and clients without Block Kit
+	Username string       `json:"username,omitempty"`
+	Blocks   []slackBlock `json:"blocks,omitempty"`
+}
+
+// slackNotifier posts the newsletter to a Slack or Mattermost incoming webhook
+type slackNotifier struct {
+	cfg *Config
+}
+
+func (n slackNotifier) Name() string { return "slack" }
+
+func (n slackNotifier) Send(ctx context.Context, issue *NewsletterIssue) error {
+	messages := buildSlackMessages(issue)
+	for i, msg := range messages {
+		if i > 0 {
+			// Incoming webhooks allow about one message per second
+			select {
+			case <-time.After(slackMessageDelay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		msg.Username = n.cfg.FromName
+		if err := pushRequest(ctx, "POST", n.cfg.SlackWebhookURL, nil, msg); err != nil {
+			return fmt.Errorf("message %d/%d: %w", i+1, len(messages), err)
+		}
+	}
+	return nil
+}
+
+// slackEscape escapes the characters Slack reserves for links and mentions
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+// slackTitle formats a title in mrkdwn, linked to IMDb when the ID is known
+func slackTitle(title, imdbID string) string {
+	if imdbID != "" {
+		return "*<https://www.imdb.com/title/" + imdbID + "/|" + slackEscape(title) + ">*"
+	}
+	return "*" + slackEscape(title) + "*"
+}
+
+// slackSection builds a mrkdwn section block, with the poster as accessory when it is public
+func slackSection(data NewsletterData, text, posterURL, altText string) slackBlock {
+	block := slackBlock{Type: "section", Text: &slackText{Type: "mrkdwn", Text: truncateRunes(text, slackMaxSectionText)}}
+	if posterURL = publicPosterURL(data, posterURL); posterURL != "" {
+		block.Accessory = &slackAccessory{Type: "image", ImageURL: posterURL, AltText: altText}
+	}
+	return block
+}
+
+// slackSeriesBlock renders a series group with one line per episode and its air date
+func slackSeriesBlock(data NewsletterData, group SeriesGroup) slackBlock {
+	lines := []string{slackTitle(group.SeriesTitle, group.IMDBID)}
+	if data.ShowSeriesRatings && group.SeriesRating > 0 {
+		lines[0] += fmt.Sprintf("  ⭐ %.1f/10", group.SeriesRating)
+	}
+	for _, ep := range group.Episodes {
+		title := ep.Title
+		if title == "" {
+			title = fmt.Sprintf("Episode %d", ep.EpisodeNum)
+		}
+		lines = append(lines, fmt.Sprintf("`S%02dE%02d` %s · %s", ep.SeasonNum, ep.EpisodeNum, slackEscape(title), formatDateWithDay(ep.AirDate)))
+	}
+	if len(group.RequestedBy) > 0 {
+		lines = append(lines, "🙋 Requested by "+slackEscape(strings.Join(group.RequestedBy, ", ")))
+	}
+	return slackSection(data, strings.Join(lines, "\n"), group.PosterURL, group.SeriesTitle)
+}
+
+// slackMovieBlock renders a movie with its release date
+func slackMovieBlock(data NewsletterData, movie Movie) slackBlock {
+	title := movie.Title
+	if movie.Year > 0 {
+		title = fmt.Sprintf("%s (%d)", movie.Title, movie.Year)
+	}
+	lines := []string{slackTitle(title, movie.IMDBID)}
+	if data.ShowSeriesRatings && movie.Rating > 0 {
+		lines[0] += fmt.Sprintf("  ⭐ %.1f/10", movie.Rating)
+	}
+	if movie.ReleaseDate != "" {
+		lines = append(lines, formatDateWithDay(movie.ReleaseDate))
+	}
+	if len(movie.RequestedBy) > 0 {
+		lines = append(lines, "🙋 Requested by "+slackEscape(strings.Join(movie.RequestedBy, ", ")))
+	}
+	return slackSection(data, strings.Join(lines, "\n"), movie.PosterURL, movie.Title)
+}
+
+// buildSlackMessages renders the newsletter as Block Kit messages of at most slackMaxBlocks blocks.
+// Every message carries the plain-text summary as fallback.
+func buildSlackMessages(issue *NewsletterIssue) []slackMessage {
+	data := issue.Data
+	fallback := issue.Subject + "\n" + newsletterSummary(data, issue.Period)
+	if issue.ArchiveURL != "" {
+		fallback += "\n" + issue.ArchiveURL
+	}
+
+	period := slackEscape(fmt.Sprintf("%s %s - %s", data.WeekRangePrefix, data.UpcomingStart, data.UpcomingEnd))
+	if issue.ArchiveURL != "" {
+		period += " · <" + issue.ArchiveURL + "|Read the full newsletter>"
+	}
+	blocks := []slackBlock{
+		{Type: "header", Text: &slackText{Type: "plain_text", Text: truncateRunes(data.EmailTitle, slackMaxHeader)}},
+		{Type: "context", Elements: []slackText{{Type: "mrkdwn", Text: period}}},
+	}
+	if data.EmailIntro != "" {
+		blocks = append(blocks, slackBlock{Type: "section", Text: &slackText{Type: "mrkdwn", Text: truncateRunes(slackEscape(data.EmailIntro), slackMaxSectionText)}})
+	}
+
+	addSection := func(heading string, items []slackBlock) {
+		if len(items) == 0 {
+			return
+		}
+		blocks = append(blocks,
+			slackBlock{Type: "divider"},
+			slackBlock{Type: "section", Text: &slackText{Type: "mrkdwn", Text: "*" + slackEscape(heading) + "*"}})
+		blocks = append(blocks, items...)
+	}
+	seriesBlocks := func(groups []SeriesGroup) []slackBlock {
+		var items []slackBlock
+		for _, group := range groups {
+			items = append(items, slackSeriesBlock(data, group))
+		}
+		return items
+	}
+	movieBlocks := func(movies []Movie) []slackBlock {
+		var items []slackBlock
+		for _, movie := range movies {
+			items = append(items, slackMovieBlock(data, movie))
+		}
+		return items
+	}
+
+	addSection("📅 "+data.ComingThisWeekHeading+" · "+data.TVShowsHeading, seriesBlocks(data.UpcomingSeriesGroups))
+	addSection("📅 "+data.ComingThisWeekHeading+" · "+data.MoviesHeading, movieBlocks(data.UpcomingMovies))
+	if data.ShowDownloaded {
+		addSection("📥 "+data.DownloadedSectionHeading+" · "+data.TVShowsHeading, seriesBlocks(data.DownloadedSeriesGroups))
+		addSection("📥 "+data.DownloadedSectionHeading+" · "+data.MoviesHeading, movieBlocks(data.DownloadedMovies))
+	}
+	if data.FooterText != "" {
+		blocks = append(blocks, slackBlock{Type: "context", Elements: []slackText{{Type: "mrkdwn", Text: slackEscape(data.FooterText)}}})
+	}
+
+	var messages []slackMessage
+	for start := 0; start < len(blocks); start += slackMaxBlocks {
+		end := start + slackMaxBlocks
+		if end > len(blocks) {
+			end = len(blocks)
+		}
+		messages = append(messages, slackMessage{Text: fallback, Blocks: blocks[start:end]})
+	}
+	return messages
+}
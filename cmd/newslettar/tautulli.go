@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Tautulli exposes a single command-style endpoint: /api/v2?apikey=...&cmd=...
+// Every response is wrapped in {"response": {"result": "success", "message": ..., "data": ...}}.
+
+// tautulliRow is one row of a get_home_stats block
+type tautulliRow struct {
+	Title         string `json:"title"`
+	Platform      string `json:"platform"`
+	Year          int    `json:"year"`
+	TotalPlays    int    `json:"total_plays"`
+	TotalDuration int    `json:"total_duration"` // seconds
+}
+
+// tautulliConfigured reports whether a Tautulli server is configured
+func tautulliConfigured(cfg *Config) bool {
+	return cfg.TautulliURL != "" && cfg.TautulliAPIKey != ""
+}
+
+// newTautulliRequest builds a GET request for a Tautulli API command
+func newTautulliRequest(ctx context.Context, baseURL, apiKey, cmd string, params url.Values) (*http.Request, error) {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("apikey", apiKey)
+	params.Set("cmd", cmd)
+	return http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(baseURL, "/")+"/api/v2?"+params.Encode(), nil)
+}
+
+// tautulliGet runs a Tautulli API command and decodes its data payload into v
+func tautulliGet(ctx context.Context, baseURL, apiKey, cmd string, params url.Values, v interface{}) error {
+	req, err := newTautulliRequest(ctx, baseURL, apiKey, cmd, params)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API request failed with status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Response struct {
+			Result  string          `json:"result"`
+			Message string          `json:"message"`
+			Data    json.RawMessage `json:"data"`
+		} `json:"response"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+	if result.Response.Result != "success" {
+		return fmt.Errorf("%s", result.Response.Message)
+	}
+	if v == nil {
+		return nil
+	}
+	return json.Unmarshal(result.Response.Data, v)
+}
+
+// tautulliTimeRange converts the newsletter period into Tautulli's "last N days" time range
+func tautulliTimeRange(since time.Time) int {
+	days := int(math.Ceil(time.Since(since).Hours() / 24))
+	if days < 1 {
+		days = 1
+	}
+	return days
+}
+
+// fetchTautulliStats returns the top movies, shows and platforms plus the total watch time since the given time
+func fetchTautulliStats(ctx context.Context, cfg *Config, since time.Time) (*ServerStats, error) {
+	if !tautulliConfigured(cfg) {
+		return nil, fmt.Errorf("Tautulli not configured")
+	}
+
+	timeRange := tautulliTimeRange(since)
+
+	// Check cache first
+	cacheKey := getCacheKey("tautulli_stats", cfg.TautulliURL, timeRange, cfg.ServerStatsLimit)
+	if cached, found := apiCache.Get(cacheKey); found {
+		log.Printf("📦 Using cached Tautulli stats")
+		return cached.(*ServerStats), nil
+	}
+
+	var homeStats []struct {
+		StatID string        `json:"stat_id"`
+		Rows   []tautulliRow `json:"rows"`
+	}
+	params := url.Values{}
+	params.Set("time_range", fmt.Sprintf("%d", timeRange))
+	params.Set("stats_type", "plays")
+	params.Set("stats_count", fmt.Sprintf("%d", cfg.ServerStatsLimit))
+	if err := tautulliGet(ctx, cfg.TautulliURL, cfg.TautulliAPIKey, "get_home_stats", params, &homeStats); err != nil {
+		return nil, err
+	}
+
+	stats := &ServerStats{}
+	for _, block := range homeStats {
+		for _, row := range block.Rows {
+			item := ServerStatItem{
+				Title:     row.Title,
+				Year:      row.Year,
+				Plays:     row.TotalPlays,
+				WatchTime: formatWatchTime(row.TotalDuration),
+			}
+			switch block.StatID {
+			case "top_movies":
+				stats.TopMovies = append(stats.TopMovies, item)
+			case "top_tv":
+				stats.TopShows = append(stats.TopShows, item)
+			case "top_platforms":
+				item.Title = row.Platform
+				stats.TopPlatforms = append(stats.TopPlatforms, item)
+			}
+		}
+	}
+
+	// get_plays_by_date returns one series per media type (TV, Movies, Music) with a value per day
+	var playsByDate struct {
+		Series []struct {
+			Name string `json:"name"`
+			Data []int  `json:"data"`
+		} `json:"series"`
+	}
+	params = url.Values{}
+	params.Set("time_range", fmt.Sprintf("%d", timeRange))
+	params.Set("y_axis", "duration")
+	if err := tautulliGet(ctx, cfg.TautulliURL, cfg.TautulliAPIKey, "get_plays_by_date", params, &playsByDate); err != nil {
+		log.Printf("⚠️  Failed to fetch Tautulli watch time: %v", err)
+	} else {
+		totalSeconds := 0
+		for _, series := range playsByDate.Series {
+			for _, seconds := range series.Data {
+				totalSeconds += seconds
+			}
+		}
+		stats.TotalWatchTime = formatWatchTime(totalSeconds)
+	}
+
+	// Store in cache
+	apiCache.Set(cacheKey, stats, cacheTTL)
+
+	return stats, nil
+}
+
+// formatWatchTime formats a duration in seconds as "2d 5h", "3h 20m" or "45m"
+func formatWatchTime(seconds int) string {
+	if seconds <= 0 {
+		return ""
+	}
+	d := time.Duration(seconds) * time.Second
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	minutes := int(d.Minutes()) % 60
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
@@ -0,0 +1,310 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Telegram posts the newsletter to each chat as a MarkdownV2 summary message followed by
+// media groups of posters, each poster captioned with its series episodes or movie details.
+
+const (
+	telegramMaxMessage    = 4096 // Characters per text message
+	telegramMaxCaption    = 1024 // Characters per photo caption
+	telegramMaxMediaGroup = 10   // Photos per media group
+	telegramMaxTitle      = 200  // Characters of a title or caption line, so one line always fits a caption
+)
+
+// telegramMarkdownEscaper escapes the characters reserved by MarkdownV2
+var telegramMarkdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "_", `\_`, "*", `\*`, "[", `\[`, "]", `\]`, "(", `\(`, ")", `\)`, "~", `\~`, "`", "\\`",
+	">", `\>`, "#", `\#`, "+", `\+`, "-", `\-`, "=", `\=`, "|", `\|`, "{", `\{`, "}", `\}`, ".", `\.`, "!", `\!`,
+)
+
+// telegramEscape escapes text for MarkdownV2 messages and captions
+func telegramEscape(s string) string {
+	return telegramMarkdownEscaper.Replace(s)
+}
+
+// telegramText escapes s, truncated so that the escaped text is at most limit characters.
+// Truncating before escaping never cuts an escape sequence in half.
+func telegramText(s string, limit int) string {
+	escaped := telegramEscape(s)
+	for n := len([]rune(s)); len([]rune(escaped)) > limit && n > 2; {
+		n = max(min(n-1, n*limit/len([]rune(escaped))), 2)
+		escaped = telegramEscape(truncateRunes(s, n))
+	}
+	return escaped
+}
+
+// telegramPhoto is an InputMediaPhoto of a media group
+type telegramPhoto struct {
+	Type      string `json:"type"`
+	Media     string `json:"media"`
+	Caption   string `json:"caption,omitempty"`
+	ParseMode string `json:"parse_mode,omitempty"`
+}
+
+// telegramNotifier posts the newsletter to the configured chats through the Bot API
+type telegramNotifier struct {
+	cfg *Config
+}
+
+func (n telegramNotifier) Name() string { return "telegram" }
+
+func (n telegramNotifier) Send(ctx context.Context, issue *NewsletterIssue) error {
+	messages := buildTelegramSummary(issue.Data)
+	photos := buildTelegramPhotos(issue.Data)
+
+	// A chat that fails (blocked bot, wrong ID) must not keep the others from getting the newsletter
+	var errs []error
+	for _, chatID := range n.cfg.TelegramChatIDs {
+		if err := telegramSendToChat(ctx, n.cfg, chatID, messages, photos); err != nil {
+			errs = append(errs, fmt.Errorf("chat %s: %w", chatID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// telegramSendToChat sends the summary messages and then the posters to one chat
+func telegramSendToChat(ctx context.Context, cfg *Config, chatID string, messages []string, photos []telegramPhoto) error {
+	for _, text := range messages {
+		if err := telegramSendMessage(ctx, cfg, chatID, text); err != nil {
+			return err
+		}
+	}
+	for start := 0; start < len(photos); start += telegramMaxMediaGroup {
+		end := start + telegramMaxMediaGroup
+		if end > len(photos) {
+			end = len(photos)
+		}
+		if err := telegramSendPhotos(ctx, cfg, chatID, photos[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// telegramCall invokes a Bot API method, waiting and retrying when Telegram rate limits the bot
+func telegramCall(ctx context.Context, apiURL, token, method string, payload interface{}, result interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	endpoint := strings.TrimSuffix(apiURL, "/") + "/bot" + token + "/" + method
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			// The error contains the request URL, which contains the bot token
+			return fmt.Errorf("%s request failed: %s", method, strings.ReplaceAll(err.Error(), token, "<token>"))
+		}
+		var apiResp struct {
+			OK          bool            `json:"ok"`
+			Description string          `json:"description"`
+			Result      json.RawMessage `json:"result"`
+			Parameters  struct {
+				RetryAfter int `json:"retry_after"` // Seconds
+			} `json:"parameters"`
+		}
+		decodeErr := json.NewDecoder(resp.Body).Decode(&apiResp)
+		resp.Body.Close()
+
+		if resp.StatusCode == http.StatusTooManyRequests && attempt < DefaultMaxRetries {
+			select {
+			case <-time.After(time.Duration(apiResp.Parameters.RetryAfter)*time.Second + 100*time.Millisecond):
+				continue
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if decodeErr != nil {
+			return fmt.Errorf("%s failed with status %d", method, resp.StatusCode)
+		}
+		if !apiResp.OK {
+			return fmt.Errorf("%s failed: %s", method, apiResp.Description)
+		}
+		if result != nil {
+			return json.Unmarshal(apiResp.Result, result)
+		}
+		return nil
+	}
+}
+
+// telegramSendMessage sends a MarkdownV2 text message to a chat
+func telegramSendMessage(ctx context.Context, cfg *Config, chatID, text string) error {
+	return telegramCall(ctx, cfg.TelegramAPIURL, cfg.TelegramBotToken, "sendMessage", map[string]interface{}{
+		"chat_id":                  chatID,
+		"text":                     text,
+		"parse_mode":               "MarkdownV2",
+		"disable_web_page_preview": true,
+	}, nil)
+}
+
+// telegramSendPhotos sends posters as a media group; a single poster is sent as a plain photo
+// because media groups need at least two items
+func telegramSendPhotos(ctx context.Context, cfg *Config, chatID string, photos []telegramPhoto) error {
+	if len(photos) == 1 {
+		return telegramCall(ctx, cfg.TelegramAPIURL, cfg.TelegramBotToken, "sendPhoto", map[string]interface{}{
+			"chat_id":    chatID,
+			"photo":      photos[0].Media,
+			"caption":    photos[0].Caption,
+			"parse_mode": photos[0].ParseMode,
+		}, nil)
+	}
+	return telegramCall(ctx, cfg.TelegramAPIURL, cfg.TelegramBotToken, "sendMediaGroup", map[string]interface{}{
+		"chat_id": chatID,
+		"media":   photos,
+	}, nil)
+}
+
+// telegramSeriesLine summarizes a series group as "*Title* S01E01, S01E02"
+func telegramSeriesLine(group SeriesGroup) string {
+	episodes := make([]string, 0, len(group.Episodes))
+	for _, ep := range group.Episodes {
+		episodes = append(episodes, fmt.Sprintf("S%02dE%02d", ep.SeasonNum, ep.EpisodeNum))
+	}
+	return "• *" + telegramText(group.SeriesTitle, telegramMaxTitle) + "* " +
+		telegramText(strings.Join(episodes, ", "), telegramMaxMessage-telegramMaxTitle-10)
+}
+
+// telegramMovieTitle formats a movie as "*Title* (2024)"
+func telegramMovieTitle(movie Movie) string {
+	title := "*" + telegramText(movie.Title, telegramMaxTitle) + "*"
+	if movie.Year > 0 {
+		title += telegramEscape(fmt.Sprintf(" (%d)", movie.Year))
+	}
+	return title
+}
+
+// buildTelegramSummary renders the newsletter as MarkdownV2 text, split into messages
+// at line boundaries to stay within Telegram's message length
+func buildTelegramSummary(data NewsletterData) []string {
+	lines := []string{
+		"*" + telegramText(data.EmailTitle, telegramMaxTitle) + "*",
+		"_" + telegramText(fmt.Sprintf("%s %s - %s", data.WeekRangePrefix, data.UpcomingStart, data.UpcomingEnd), telegramMaxTitle) + "_",
+	}
+	if data.EmailIntro != "" {
+		lines = append(lines, "", telegramText(data.EmailIntro, telegramMaxMessage))
+	}
+
+	addSection := func(heading string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+		lines = append(lines, "", "*"+telegramText(fmt.Sprintf("%s (%d)", heading, len(items)), telegramMaxTitle)+"*")
+		lines = append(lines, items...)
+	}
+	seriesLines := func(groups []SeriesGroup) []string {
+		var items []string
+		for _, group := range groups {
+			items = append(items, telegramSeriesLine(group))
+		}
+		return items
+	}
+	movieLines := func(movies []Movie) []string {
+		var items []string
+		for _, movie := range movies {
+			items = append(items, "• "+telegramMovieTitle(movie))
+		}
+		return items
+	}
+
+	addSection("📅 "+data.ComingThisWeekHeading+" · "+data.TVShowsHeading, seriesLines(data.UpcomingSeriesGroups))
+	addSection("📅 "+data.ComingThisWeekHeading+" · "+data.MoviesHeading, movieLines(data.UpcomingMovies))
+	if data.ShowDownloaded {
+		addSection("📥 "+data.DownloadedSectionHeading+" · "+data.TVShowsHeading, seriesLines(data.DownloadedSeriesGroups))
+		addSection("📥 "+data.DownloadedSectionHeading+" · "+data.MoviesHeading, movieLines(data.DownloadedMovies))
+	}
+	if data.FooterText != "" {
+		lines = append(lines, "", "_"+telegramText(data.FooterText, telegramMaxMessage-2)+"_")
+	}
+
+	var messages []string
+	current := ""
+	for _, line := range lines {
+		if current != "" && len([]rune(current))+1+len([]rune(line)) > telegramMaxMessage {
+			messages = append(messages, current)
+			current = ""
+		}
+		if current != "" {
+			current += "\n"
+		}
+		current += line
+	}
+	if strings.TrimSpace(current) != "" {
+		messages = append(messages, current)
+	}
+	return messages
+}
+
+// telegramCaption joins caption lines, leaving out the lines that would exceed the caption length
+func telegramCaption(lines []string) string {
+	caption := lines[0]
+	for i, line := range lines[1:] {
+		if len([]rune(caption))+1+len([]rune(line)) > telegramMaxCaption-30 {
+			return caption + "\n" + telegramEscape(fmt.Sprintf("… and %d more", len(lines)-1-i))
+		}
+		caption += "\n" + line
+	}
+	return caption
+}
+
+// buildTelegramPhotos returns one captioned poster per series group and movie that has a public poster
+func buildTelegramPhotos(data NewsletterData) []telegramPhoto {
+	var photos []telegramPhoto
+	addPhoto := func(posterURL string, lines []string) {
+		if posterURL = publicPosterURL(data, posterURL); posterURL == "" {
+			return
+		}
+		photos = append(photos, telegramPhoto{Type: "photo", Media: posterURL, Caption: telegramCaption(lines), ParseMode: "MarkdownV2"})
+	}
+	addSeries := func(groups []SeriesGroup) {
+		for _, group := range groups {
+			lines := []string{"*" + telegramText(group.SeriesTitle, telegramMaxTitle) + "*"}
+			if data.ShowSeriesRatings && group.SeriesRating > 0 {
+				lines[0] += telegramEscape(fmt.Sprintf(" ⭐ %.1f/10", group.SeriesRating))
+			}
+			for _, ep := range group.Episodes {
+				title := ep.Title
+				if title == "" {
+					title = fmt.Sprintf("Episode %d", ep.EpisodeNum)
+				}
+				lines = append(lines, telegramText(fmt.Sprintf("S%02dE%02d %s · %s", ep.SeasonNum, ep.EpisodeNum, title, formatDateWithDay(ep.AirDate)), telegramMaxTitle))
+			}
+			addPhoto(group.PosterURL, lines)
+		}
+	}
+	addMovies := func(movies []Movie) {
+		for _, movie := range movies {
+			lines := []string{telegramMovieTitle(movie)}
+			if data.ShowSeriesRatings && movie.Rating > 0 {
+				lines[0] += telegramEscape(fmt.Sprintf(" ⭐ %.1f/10", movie.Rating))
+			}
+			if movie.ReleaseDate != "" {
+				lines = append(lines, telegramEscape(formatDateWithDay(movie.ReleaseDate)))
+			}
+			addPhoto(movie.PosterURL, lines)
+		}
+	}
+
+	addSeries(data.UpcomingSeriesGroups)
+	addMovies(data.UpcomingMovies)
+	if data.ShowDownloaded {
+		addSeries(data.DownloadedSeriesGroups)
+		addMovies(data.DownloadedMovies)
+	}
+	return photos
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTelegramEscape(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"plain text", "plain text"},
+		{"S01E02 - Pilot.", `S01E02 \- Pilot\.`},
+		{"Movie (2024)!", `Movie \(2024\)\!`},
+		{`a\b`, `a\\b`},
+		{"_*[]()~`>#+-=|{}.!", "\\_\\*\\[\\]\\(\\)\\~\\`\\>\\#\\+\\-\\=\\|\\{\\}\\.\\!"},
+	}
+	for _, tt := range tests {
+		if got := telegramEscape(tt.in); got != tt.want {
+			t.Errorf("telegramEscape(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestTelegramText(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		limit int
+		want  string // Checked when set, the length limit is always checked
+	}{
+		{name: "fits", in: "Hello.", limit: 10, want: `Hello\.`},
+		{name: "fits exactly", in: "Hello.", limit: 7, want: `Hello\.`},
+		{name: "plain text", in: strings.Repeat("a", 5000), limit: telegramMaxMessage},
+		{name: "only escaped characters", in: strings.Repeat(".", 5000), limit: telegramMaxMessage},
+		{name: "escaped characters at the cut", in: strings.Repeat("a", 1020) + strings.Repeat("-", 10), limit: telegramMaxCaption},
+		{name: "multi-byte characters", in: strings.Repeat("é!", 3000), limit: telegramMaxCaption},
+		{name: "caption of escaped characters", in: strings.Repeat("(", 1500), limit: telegramMaxCaption},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := telegramText(tt.in, tt.limit)
+			if n := utf8.RuneCountInString(got); n > tt.limit {
+				t.Errorf("telegramText() is %d characters, want at most %d", n, tt.limit)
+			}
+			if tt.want != "" && got != tt.want {
+				t.Errorf("telegramText(%q, %d) = %q, want %q", tt.in, tt.limit, got, tt.want)
+			}
+			// A cut escape sequence would leave a lone backslash before the ellipsis
+			if strings.HasSuffix(got, `\…`) {
+				t.Errorf("telegramText() cut an escape sequence: %q", got[len(got)-10:])
+			}
+			if utf8.RuneCountInString(tt.in) > tt.limit && !strings.HasSuffix(got, "…") {
+				t.Errorf("telegramText() of an over-long text doesn't end with an ellipsis")
+			}
+		})
+	}
+}
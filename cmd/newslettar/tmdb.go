@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TMDB resolves posters and backdrops for Trakt items, which only carry IDs.
+// Both v3 API keys (api_key query parameter) and v4 read access tokens (Bearer header) are accepted.
+
+const (
+	tmdbAPIURL       = "https://api.themoviedb.org/3"
+	tmdbImageURL     = "https://image.tmdb.org/t/p"
+	tmdbPosterSize   = "w342"
+	tmdbBackdropSize = "w780"
+	tmdbCacheTTL     = 30 * 24 * time.Hour // Artwork rarely changes, keep it for a month
+	tmdbConcurrency  = 5
+)
+
+// tmdbImages are the resolved artwork URLs of one title (empty when TMDB has none)
+type tmdbImages struct {
+	PosterURL   string
+	BackdropURL string
+}
+
+// tmdbGet requests a TMDB API path and decodes the response into v
+func tmdbGet(ctx context.Context, apiKey, path string, params url.Values, v interface{}) error {
+	if params == nil {
+		params = url.Values{}
+	}
+	// v4 read access tokens are JWTs, v3 keys are plain hex strings
+	bearer := strings.Count(apiKey, ".") == 2
+	if !bearer {
+		params.Set("api_key", apiKey)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", tmdbAPIURL+path+"?"+params.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if bearer {
+		req.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("TMDB API error (status %d)", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// tmdbImageLink builds the full image URL for a TMDB file path
+func tmdbImageLink(size, path string) string {
+	if path == "" {
+		return ""
+	}
+	return tmdbImageURL + "/" + size + path
+}
+
+// fetchTMDBImages returns the poster and backdrop of a TMDB title ("tv" or "movie").
+// Shows without a TMDB ID are looked up by their TVDB or IMDB ID.
+// Results, including titles without artwork, are cached for tmdbCacheTTL.
+func fetchTMDBImages(ctx context.Context, cfg *Config, mediaType string, tmdbID, tvdbID int, imdbID string) tmdbImages {
+	cacheKey := getCacheKey("tmdb_images", mediaType, tmdbID, tvdbID, imdbID)
+	if cached, found := apiCache.Get(cacheKey); found {
+		return cached.(tmdbImages)
+	}
+
+	var result struct {
+		PosterPath   string `json:"poster_path"`
+		BackdropPath string `json:"backdrop_path"`
+	}
+
+	var err error
+	switch {
+	case tmdbID > 0:
+		err = tmdbGet(ctx, cfg.TMDBAPIKey, fmt.Sprintf("/%s/%d", mediaType, tmdbID), nil, &result)
+	case tvdbID > 0 || imdbID != "":
+		externalID, source := imdbID, "imdb_id"
+		if tvdbID > 0 {
+			externalID, source = fmt.Sprintf("%d", tvdbID), "tvdb_id"
+		}
+		var found map[string][]struct {
+			PosterPath   string `json:"poster_path"`
+			BackdropPath string `json:"backdrop_path"`
+		}
+		err = tmdbGet(ctx, cfg.TMDBAPIKey, "/find/"+externalID, url.Values{"external_source": {source}}, &found)
+		if matches := found[mediaType+"_results"]; len(matches) > 0 {
+			result.PosterPath, result.BackdropPath = matches[0].PosterPath, matches[0].BackdropPath
+		}
+	default:
+		return tmdbImages{}
+	}
+	if err != nil {
+		// Don't cache failures so the next run retries
+		log.Printf("⚠️  TMDB lookup failed for %s %d: %v", mediaType, tmdbID, err)
+		return tmdbImages{}
+	}
+
+	images := tmdbImages{
+		PosterURL:   tmdbImageLink(tmdbPosterSize, result.PosterPath),
+		BackdropURL: tmdbImageLink(tmdbBackdropSize, result.BackdropPath),
+	}
+	apiCache.Set(cacheKey, images, tmdbCacheTTL)
+	return images
+}
+
+// addTraktShowImages fills in the poster and backdrop of each show when a TMDB API key is configured
+func addTraktShowImages(ctx context.Context, cfg *Config, shows []TraktShow) {
+	if cfg.TMDBAPIKey == "" {
+		return
+	}
+	forEachLimited(len(shows), func(i int) {
+		images := fetchTMDBImages(ctx, cfg, "tv", shows[i].TMDBID, shows[i].TVDBID, shows[i].IMDBID)
+		shows[i].ImageURL, shows[i].BackdropURL = images.PosterURL, images.BackdropURL
+	})
+}
+
+// addTraktMovieImages fills in the poster and backdrop of each movie when a TMDB API key is configured
+func addTraktMovieImages(ctx context.Context, cfg *Config, movies []TraktMovie) {
+	if cfg.TMDBAPIKey == "" {
+		return
+	}
+	forEachLimited(len(movies), func(i int) {
+		images := fetchTMDBImages(ctx, cfg, "movie", movies[i].TMDBID, 0, movies[i].IMDBID)
+		movies[i].ImageURL, movies[i].BackdropURL = images.PosterURL, images.BackdropURL
+	})
+}
+
+// forEachLimited runs fn for 0..n-1 with at most tmdbConcurrency calls in flight
+func forEachLimited(n int, fn func(i int)) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, tmdbConcurrency)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
@@ -6,13 +6,16 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 )
 
-// Trakt API response structures
+// Trakt API response structures.
+// Calendar entries also carry the air/release date of the entry at the top level.
 type traktShowResponse struct {
-	Show struct {
+	FirstAired string `json:"first_aired"` // Calendar: episode air date
+	Show       struct {
 		Title      string  `json:"title"`
 		Year       int     `json:"year"`
 		FirstAired string  `json:"first_aired"`
@@ -29,7 +32,8 @@ type traktShowResponse struct {
 }
 
 type traktMovieResponse struct {
-	Movie struct {
+	Released string `json:"released"` // Calendar: release date
+	Movie    struct {
 		Title    string  `json:"title"`
 		Year     int     `json:"year"`
 		Released string  `json:"released"`
@@ -43,25 +47,32 @@ type traktMovieResponse struct {
 	} `json:"movie"`
 }
 
-// getSonarrLibrary fetches and caches the Sonarr library (optimized: only IDs)
+// getSonarrLibrary fetches and caches the combined library of all Sonarr instances (optimized: only IDs)
 func getSonarrLibrary(ctx context.Context, cfg *Config) map[string]bool {
-	if cfg.SonarrURL == "" || cfg.SonarrAPIKey == "" {
-		return make(map[string]bool)
+	library := make(map[string]bool)
+	for _, inst := range configuredInstances(cfg.SonarrInstances) {
+		for key := range getSonarrInstanceLibrary(ctx, inst) {
+			library[key] = true
+		}
 	}
+	return library
+}
 
+// getSonarrInstanceLibrary fetches and caches the library of a single Sonarr instance
+func getSonarrInstanceLibrary(ctx context.Context, inst ArrInstance) map[string]bool {
 	// Check cache first
-	cacheKey := getCacheKey("sonarr_library", cfg.SonarrAPIKey, 0)
+	cacheKey := getCacheKey("sonarr_library", inst.APIKey, inst.URL)
 	if cached, found := apiCache.Get(cacheKey); found {
 		return cached.(map[string]bool)
 	}
 
-	url := fmt.Sprintf("%s/api/v3/series", cfg.SonarrURL)
+	url := fmt.Sprintf("%s/api/v3/series", inst.URL)
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return make(map[string]bool)
 	}
 
-	req.Header.Set("X-Api-Key", cfg.SonarrAPIKey)
+	req.Header.Set("X-Api-Key", inst.APIKey)
 
 	// Use global HTTP client with connection pooling for better performance
 	resp, err := httpClient.Do(req)
@@ -100,29 +111,36 @@ func getSonarrLibrary(ctx context.Context, cfg *Config) map[string]bool {
 
 	// Cache for 5 minutes
 	apiCache.Set(cacheKey, library, cacheTTL)
-	log.Printf("📚 Cached %d monitored series from Sonarr", len(library))
+	log.Printf("📚 Cached %d monitored series from %s", len(library), inst.Name)
 	return library
 }
 
-// getRadarrLibrary fetches and caches the Radarr library (optimized: only IDs)
+// getRadarrLibrary fetches and caches the combined library of all Radarr instances (optimized: only IDs)
 func getRadarrLibrary(ctx context.Context, cfg *Config) map[string]bool {
-	if cfg.RadarrURL == "" || cfg.RadarrAPIKey == "" {
-		return make(map[string]bool)
+	library := make(map[string]bool)
+	for _, inst := range configuredInstances(cfg.RadarrInstances) {
+		for key := range getRadarrInstanceLibrary(ctx, inst) {
+			library[key] = true
+		}
 	}
+	return library
+}
 
+// getRadarrInstanceLibrary fetches and caches the library of a single Radarr instance
+func getRadarrInstanceLibrary(ctx context.Context, inst ArrInstance) map[string]bool {
 	// Check cache first
-	cacheKey := getCacheKey("radarr_library", cfg.RadarrAPIKey, 0)
+	cacheKey := getCacheKey("radarr_library", inst.APIKey, inst.URL)
 	if cached, found := apiCache.Get(cacheKey); found {
 		return cached.(map[string]bool)
 	}
 
-	url := fmt.Sprintf("%s/api/v3/movie", cfg.RadarrURL)
+	url := fmt.Sprintf("%s/api/v3/movie", inst.URL)
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return make(map[string]bool)
 	}
 
-	req.Header.Set("X-Api-Key", cfg.RadarrAPIKey)
+	req.Header.Set("X-Api-Key", inst.APIKey)
 
 	// Use global HTTP client with connection pooling for better performance
 	resp, err := httpClient.Do(req)
@@ -161,47 +179,57 @@ func getRadarrLibrary(ctx context.Context, cfg *Config) map[string]bool {
 
 	// Cache for 5 minutes
 	apiCache.Set(cacheKey, library, cacheTTL)
-	log.Printf("📚 Cached %d monitored movies from Radarr", len(library))
+	log.Printf("📚 Cached %d monitored movies from %s", len(library), inst.Name)
 	return library
 }
 
-// isShowInLibrary checks if a show exists in the cached library
-func isShowInLibrary(library map[string]bool, imdbID string, tvdbID int) bool {
-	if imdbID != "" && library["imdb:"+imdbID] {
-		return true
+// isShowInLibrary checks if a show exists in a cached library.
+// Works with the Sonarr ID set as well as the Plex ID → rating key map.
+func isShowInLibrary[V any](library map[string]V, imdbID string, tvdbID int) bool {
+	if imdbID != "" {
+		if _, ok := library["imdb:"+imdbID]; ok {
+			return true
+		}
 	}
-	if tvdbID > 0 && library[fmt.Sprintf("tvdb:%d", tvdbID)] {
-		return true
+	if tvdbID > 0 {
+		if _, ok := library[fmt.Sprintf("tvdb:%d", tvdbID)]; ok {
+			return true
+		}
 	}
 	return false
 }
 
-// isMovieInLibrary checks if a movie exists in the cached library
-func isMovieInLibrary(library map[string]bool, imdbID string, tmdbID int) bool {
-	if imdbID != "" && library["imdb:"+imdbID] {
-		return true
+// isMovieInLibrary checks if a movie exists in a cached library.
+// Works with the Radarr ID set as well as the Plex ID → rating key map.
+func isMovieInLibrary[V any](library map[string]V, imdbID string, tmdbID int) bool {
+	if imdbID != "" {
+		if _, ok := library["imdb:"+imdbID]; ok {
+			return true
+		}
 	}
-	if tmdbID > 0 && library[fmt.Sprintf("tmdb:%d", tmdbID)] {
-		return true
+	if tmdbID > 0 {
+		if _, ok := library[fmt.Sprintf("tmdb:%d", tmdbID)]; ok {
+			return true
+		}
 	}
 	return false
 }
 
-// fetchTraktAnticipatedSeries fetches the most anticipated series of the coming week from Trakt
-func fetchTraktAnticipatedSeries(ctx context.Context, cfg *Config) ([]TraktShow, error) {
+// fetchTraktAnticipatedSeries fetches the most anticipated series premiering in the upcoming newsletter period
+func fetchTraktAnticipatedSeries(ctx context.Context, cfg *Config, period newsletterPeriod) ([]TraktShow, error) {
 	if cfg.TraktClientID == "" {
 		return nil, nil
 	}
 
 	// Check cache first
-	cacheKey := getCacheKey("trakt_anticipated_series", cfg.TraktClientID, 0)
+	cacheKey := getCacheKey("trakt_anticipated_series", cfg.TraktClientID, period.cacheKey())
 	if cached, found := apiCache.Get(cacheKey); found {
 		log.Printf("📦 Using cached Trakt anticipated series")
 		return cached.([]TraktShow), nil
 	}
 
 	url := "https://api.trakt.tv/shows/anticipated"
-	shows, err := fetchTraktShows(ctx, cfg, url, true) // Filter to next week only
+	shows, err := fetchTraktShows(ctx, cfg, url, &period) // Filter to the upcoming period only
 	if err != nil {
 		return nil, err
 	}
@@ -211,21 +239,21 @@ func fetchTraktAnticipatedSeries(ctx context.Context, cfg *Config) ([]TraktShow,
 	return shows, nil
 }
 
-// fetchTraktWatchedSeries fetches the most watched series of the last week from Trakt
-func fetchTraktWatchedSeries(ctx context.Context, cfg *Config) ([]TraktShow, error) {
+// fetchTraktWatchedSeries fetches the most watched series of the last newsletter period
+func fetchTraktWatchedSeries(ctx context.Context, cfg *Config, period newsletterPeriod) ([]TraktShow, error) {
 	if cfg.TraktClientID == "" {
 		return nil, nil
 	}
 
 	// Check cache first
-	cacheKey := getCacheKey("trakt_watched_series", cfg.TraktClientID, 0)
+	cacheKey := getCacheKey("trakt_watched_series", cfg.TraktClientID, period.cacheKey())
 	if cached, found := apiCache.Get(cacheKey); found {
 		log.Printf("📦 Using cached Trakt watched series")
 		return cached.([]TraktShow), nil
 	}
 
-	url := "https://api.trakt.tv/shows/watched/weekly"
-	shows, err := fetchTraktShows(ctx, cfg, url, false) // No date filtering for watched
+	url := "https://api.trakt.tv/shows/watched/" + period.traktPeriod()
+	shows, err := fetchTraktShows(ctx, cfg, url, nil) // No date filtering for watched
 	if err != nil {
 		return nil, err
 	}
@@ -235,21 +263,21 @@ func fetchTraktWatchedSeries(ctx context.Context, cfg *Config) ([]TraktShow, err
 	return shows, nil
 }
 
-// fetchTraktAnticipatedMovies fetches the most anticipated movies of the coming week from Trakt
-func fetchTraktAnticipatedMovies(ctx context.Context, cfg *Config) ([]TraktMovie, error) {
+// fetchTraktAnticipatedMovies fetches the most anticipated movies releasing in the upcoming newsletter period
+func fetchTraktAnticipatedMovies(ctx context.Context, cfg *Config, period newsletterPeriod) ([]TraktMovie, error) {
 	if cfg.TraktClientID == "" {
 		return nil, nil
 	}
 
 	// Check cache first
-	cacheKey := getCacheKey("trakt_anticipated_movies", cfg.TraktClientID, 0)
+	cacheKey := getCacheKey("trakt_anticipated_movies", cfg.TraktClientID, period.cacheKey())
 	if cached, found := apiCache.Get(cacheKey); found {
 		log.Printf("📦 Using cached Trakt anticipated movies")
 		return cached.([]TraktMovie), nil
 	}
 
 	url := "https://api.trakt.tv/movies/anticipated"
-	movies, err := fetchTraktMovies(ctx, cfg, url, true) // Filter to next week only
+	movies, err := fetchTraktMovies(ctx, cfg, url, &period) // Filter to the upcoming period only
 	if err != nil {
 		return nil, err
 	}
@@ -259,21 +287,21 @@ func fetchTraktAnticipatedMovies(ctx context.Context, cfg *Config) ([]TraktMovie
 	return movies, nil
 }
 
-// fetchTraktWatchedMovies fetches the most watched movies of the last week from Trakt
-func fetchTraktWatchedMovies(ctx context.Context, cfg *Config) ([]TraktMovie, error) {
+// fetchTraktWatchedMovies fetches the most watched movies of the last newsletter period
+func fetchTraktWatchedMovies(ctx context.Context, cfg *Config, period newsletterPeriod) ([]TraktMovie, error) {
 	if cfg.TraktClientID == "" {
 		return nil, nil
 	}
 
 	// Check cache first
-	cacheKey := getCacheKey("trakt_watched_movies", cfg.TraktClientID, 0)
+	cacheKey := getCacheKey("trakt_watched_movies", cfg.TraktClientID, period.cacheKey())
 	if cached, found := apiCache.Get(cacheKey); found {
 		log.Printf("📦 Using cached Trakt watched movies")
 		return cached.([]TraktMovie), nil
 	}
 
-	url := "https://api.trakt.tv/movies/watched/weekly"
-	movies, err := fetchTraktMovies(ctx, cfg, url, false) // No date filtering for watched
+	url := "https://api.trakt.tv/movies/watched/" + period.traktPeriod()
+	movies, err := fetchTraktMovies(ctx, cfg, url, nil) // No date filtering for watched
 	if err != nil {
 		return nil, err
 	}
@@ -283,16 +311,14 @@ func fetchTraktWatchedMovies(ctx context.Context, cfg *Config) ([]TraktMovie, er
 	return movies, nil
 }
 
-// fetchTraktShows is a helper function to fetch shows from Trakt API
-func fetchTraktShows(ctx context.Context, cfg *Config, url string, filterToNextWeek bool) ([]TraktShow, error) {
-	// Fetch Sonarr library once (cached for 5 minutes)
-	sonarrLibrary := getSonarrLibrary(ctx, cfg)
-
-	// Add extended parameter to get full details
-	if len(url) > 0 && url[len(url)-1] != '?' {
-		url += "?extended=full"
-	}
+// traktUserEndpoint reports whether a Trakt URL reads data of the connected account
+func traktUserEndpoint(url string) bool {
+	return strings.Contains(url, "/sync/") || strings.Contains(url, "/recommendations/") || strings.Contains(url, "/calendars/my/")
+}
 
+// newTraktRequest builds a Trakt API GET request, authenticated with the
+// connected account's access token for user endpoints
+func newTraktRequest(ctx context.Context, cfg *Config, url string) (*http.Request, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Trakt request: %w", err)
@@ -303,6 +329,66 @@ func fetchTraktShows(ctx context.Context, cfg *Config, url string, filterToNextW
 	req.Header.Set("trakt-api-version", "2")
 	req.Header.Set("trakt-api-key", cfg.TraktClientID)
 
+	if traktUserEndpoint(url) {
+		token, err := getTraktAccessToken(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else if strings.Contains(url, "/lists/") && traktConnected() {
+		// Custom lists are usually public, but the connected account may also read its private lists
+		if token, err := getTraktAccessToken(ctx, cfg); err == nil {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+	return req, nil
+}
+
+// traktLimit returns the configured number of results for a Trakt endpoint
+func traktLimit(url string, anticipatedLimit, watchedLimit int, cfg *Config) int {
+	limit := 5 // Default limit
+	switch {
+	case strings.Contains(url, "/anticipated"):
+		limit = anticipatedLimit
+	case strings.Contains(url, "/watched"):
+		limit = watchedLimit
+	case strings.Contains(url, "/recommendations/"):
+		limit = cfg.TraktRecommendationsLimit
+	case strings.Contains(url, "/calendars/"):
+		limit = cfg.TraktCalendarLimit
+	case strings.Contains(url, "/sync/watchlist"):
+		return cfg.APIPageSize // Filtered against the downloads afterwards
+	case strings.Contains(url, "/items/"):
+		return cfg.APIPageSize // Custom lists are limited by the request itself
+	}
+	if limit <= 0 {
+		limit = 5 // Fallback to 5 if invalid
+	}
+	return limit
+}
+
+// fetchTraktShows is a helper function to fetch shows from Trakt API.
+// With a release window, only shows premiering in its upcoming part are kept.
+func fetchTraktShows(ctx context.Context, cfg *Config, url string, releaseWindow *newsletterPeriod) ([]TraktShow, error) {
+	// Fetch Sonarr and Plex libraries once (cached for 5 minutes)
+	sonarrLibrary := getSonarrLibrary(ctx, cfg)
+	plexLibrary, err := getPlexLibrary(ctx, cfg)
+	if err != nil {
+		log.Printf("⚠️  Plex library error: %v", err)
+	}
+
+	// Add extended parameter to get full details
+	if strings.Contains(url, "?") {
+		url += "&extended=full"
+	} else {
+		url += "?extended=full"
+	}
+
+	req, err := newTraktRequest(ctx, cfg, url)
+	if err != nil {
+		return nil, err
+	}
+
 	// Use global HTTP client with connection pooling for better performance
 	resp, err := httpClient.Do(req)
 	if err != nil {
@@ -315,42 +401,41 @@ func fetchTraktShows(ctx context.Context, cfg *Config, url string, filterToNextW
 	}
 
 	// Use streaming JSON decoder for better memory efficiency
-	var responses []traktShowResponse
-	if err := json.NewDecoder(resp.Body).Decode(&responses); err != nil {
+	// Most endpoints wrap each item ({"show": {...}}), recommendations return the bare item
+	var raw []json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
 		return nil, fmt.Errorf("failed to parse Trakt response: %w", err)
 	}
-
-	// Calculate next week's date range for filtering
-	now := time.Now()
-	nextWeekStart := now
-	nextWeekEnd := now.AddDate(0, 0, 7)
+	responses := make([]traktShowResponse, len(raw))
+	for i, item := range raw {
+		if err := json.Unmarshal(item, &responses[i]); err != nil {
+			return nil, fmt.Errorf("failed to parse Trakt response: %w", err)
+		}
+		if responses[i].Show.Title == "" {
+			json.Unmarshal(item, &responses[i].Show)
+		}
+	}
 
 	// Determine limit based on which endpoint we're fetching from
-	limit := 5 // Default limit
-	if strings.Contains(url, "/anticipated") {
-		limit = cfg.TraktAnticipatedSeriesLimit
-	} else if strings.Contains(url, "/watched") {
-		limit = cfg.TraktWatchedSeriesLimit
-	}
-	if limit <= 0 {
-		limit = 5 // Fallback to 5 if invalid
-	}
+	limit := traktLimit(url, cfg.TraktAnticipatedSeriesLimit, cfg.TraktWatchedSeriesLimit, cfg)
 
 	// Convert to our format
-	shows := make([]TraktShow, 0, limit)
+	shows := []TraktShow{}
+	seen := make(map[string]bool) // Calendars list every episode of a show
 	for _, resp := range responses {
 		if len(shows) >= limit {
 			break
 		}
+		if seen[resp.Show.IDs.Slug+resp.Show.Title] {
+			continue
+		}
+		seen[resp.Show.IDs.Slug+resp.Show.Title] = true
 
-		// If filtering to next week and we have a first_aired date, check it
-		if filterToNextWeek && resp.Show.FirstAired != "" {
+		// If filtering to the upcoming period and we have a first_aired date, check it
+		if releaseWindow != nil && resp.Show.FirstAired != "" {
 			firstAired, err := time.Parse(time.RFC3339, resp.Show.FirstAired)
-			if err == nil {
-				// Skip if not premiering in the next 7 days
-				if firstAired.Before(nextWeekStart) || firstAired.After(nextWeekEnd) {
-					continue
-				}
+			if err == nil && !releaseWindow.upcoming(firstAired) {
+				continue
 			}
 		}
 
@@ -361,41 +446,54 @@ func fetchTraktShows(ctx context.Context, cfg *Config, url string, filterToNextW
 			ReleaseDate: resp.Show.FirstAired,
 			Network:     resp.Show.Network,
 			IMDBID:      resp.Show.IDs.IMDB,
+			TVDBID:      resp.Show.IDs.TVDB,
+			TMDBID:      resp.Show.IDs.TMDB,
 			Rating:      resp.Show.Rating,
 			InLibrary:   isShowInLibrary(sonarrLibrary, resp.Show.IDs.IMDB, resp.Show.IDs.TVDB),
+			PlexURL:     plexLibrary.showURL(resp.Show.IDs.IMDB, resp.Show.IDs.TVDB),
+		}
+		if plexLibrary != nil && isShowInLibrary(plexLibrary.Shows, resp.Show.IDs.IMDB, resp.Show.IDs.TVDB) {
+			show.InLibrary = true
+		}
+		if resp.FirstAired != "" {
+			show.ReleaseDate = resp.FirstAired
 		}
-
-		// Images are not available from Trakt API directly
-		// Would need TMDB/TVDB API integration for posters
-		show.ImageURL = ""
 
 		shows = append(shows, show)
 	}
 
+	// Trakt only returns IDs, posters come from TMDB.
+	// Watchlists are resolved after filtering them down to what became available.
+	if !strings.Contains(url, "/sync/watchlist") {
+		addTraktShowImages(ctx, cfg, shows)
+	}
+
 	log.Printf("✅ Fetched %d shows from Trakt", len(shows))
 	return shows, nil
 }
 
-// fetchTraktMovies is a helper function to fetch movies from Trakt API
-func fetchTraktMovies(ctx context.Context, cfg *Config, url string, filterToNextWeek bool) ([]TraktMovie, error) {
-	// Fetch Radarr library once (cached for 5 minutes)
+// fetchTraktMovies is a helper function to fetch movies from Trakt API.
+// With a release window, only movies releasing in its upcoming part are kept.
+func fetchTraktMovies(ctx context.Context, cfg *Config, url string, releaseWindow *newsletterPeriod) ([]TraktMovie, error) {
+	// Fetch Radarr and Plex libraries once (cached for 5 minutes)
 	radarrLibrary := getRadarrLibrary(ctx, cfg)
+	plexLibrary, err := getPlexLibrary(ctx, cfg)
+	if err != nil {
+		log.Printf("⚠️  Plex library error: %v", err)
+	}
 
 	// Add extended parameter to get full details
-	if len(url) > 0 && url[len(url)-1] != '?' {
+	if strings.Contains(url, "?") {
+		url += "&extended=full"
+	} else {
 		url += "?extended=full"
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	req, err := newTraktRequest(ctx, cfg, url)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create Trakt request: %w", err)
+		return nil, err
 	}
 
-	// Trakt requires these headers
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("trakt-api-version", "2")
-	req.Header.Set("trakt-api-key", cfg.TraktClientID)
-
 	// Use global HTTP client with connection pooling for better performance
 	resp, err := httpClient.Do(req)
 	if err != nil {
@@ -408,42 +506,36 @@ func fetchTraktMovies(ctx context.Context, cfg *Config, url string, filterToNext
 	}
 
 	// Use streaming JSON decoder for better memory efficiency
-	var responses []traktMovieResponse
-	if err := json.NewDecoder(resp.Body).Decode(&responses); err != nil {
+	// Most endpoints wrap each item ({"movie": {...}}), recommendations return the bare item
+	var raw []json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
 		return nil, fmt.Errorf("failed to parse Trakt response: %w", err)
 	}
-
-	// Calculate next week's date range for filtering
-	now := time.Now()
-	nextWeekStart := now
-	nextWeekEnd := now.AddDate(0, 0, 7)
+	responses := make([]traktMovieResponse, len(raw))
+	for i, item := range raw {
+		if err := json.Unmarshal(item, &responses[i]); err != nil {
+			return nil, fmt.Errorf("failed to parse Trakt response: %w", err)
+		}
+		if responses[i].Movie.Title == "" {
+			json.Unmarshal(item, &responses[i].Movie)
+		}
+	}
 
 	// Determine limit based on which endpoint we're fetching from
-	limit := 5 // Default limit
-	if strings.Contains(url, "/anticipated") {
-		limit = cfg.TraktAnticipatedMoviesLimit
-	} else if strings.Contains(url, "/watched") {
-		limit = cfg.TraktWatchedMoviesLimit
-	}
-	if limit <= 0 {
-		limit = 5 // Fallback to 5 if invalid
-	}
+	limit := traktLimit(url, cfg.TraktAnticipatedMoviesLimit, cfg.TraktWatchedMoviesLimit, cfg)
 
 	// Convert to our format
-	movies := make([]TraktMovie, 0, limit)
+	movies := []TraktMovie{}
 	for _, resp := range responses {
 		if len(movies) >= limit {
 			break
 		}
 
-		// If filtering to next week and we have a released date, check it
-		if filterToNextWeek && resp.Movie.Released != "" {
+		// If filtering to the upcoming period and we have a released date, check it
+		if releaseWindow != nil && resp.Movie.Released != "" {
 			released, err := time.Parse("2006-01-02", resp.Movie.Released)
-			if err == nil {
-				// Skip if not releasing in the next 7 days
-				if released.Before(nextWeekStart) || released.After(nextWeekEnd) {
-					continue
-				}
+			if err == nil && !releaseWindow.upcoming(released) {
+				continue
 			}
 		}
 
@@ -453,17 +545,205 @@ func fetchTraktMovies(ctx context.Context, cfg *Config, url string, filterToNext
 			Overview:    resp.Movie.Overview,
 			ReleaseDate: resp.Movie.Released,
 			IMDBID:      resp.Movie.IDs.IMDB,
+			TMDBID:      resp.Movie.IDs.TMDB,
 			Rating:      resp.Movie.Rating,
 			InLibrary:   isMovieInLibrary(radarrLibrary, resp.Movie.IDs.IMDB, resp.Movie.IDs.TMDB),
+			PlexURL:     plexLibrary.movieURL(resp.Movie.IDs.IMDB, resp.Movie.IDs.TMDB),
+		}
+		if plexLibrary != nil && isMovieInLibrary(plexLibrary.Movies, resp.Movie.IDs.IMDB, resp.Movie.IDs.TMDB) {
+			movie.InLibrary = true
+		}
+		if resp.Released != "" {
+			movie.ReleaseDate = resp.Released
 		}
-
-		// Images are not available from Trakt API directly
-		// Would need TMDB/TVDB API integration for posters
-		movie.ImageURL = ""
 
 		movies = append(movies, movie)
 	}
 
+	// Trakt only returns IDs, posters come from TMDB.
+	// Watchlists are resolved after filtering them down to what became available.
+	if !strings.Contains(url, "/sync/watchlist") {
+		addTraktMovieImages(ctx, cfg, movies)
+	}
+
 	log.Printf("✅ Fetched %d movies from Trakt", len(movies))
 	return movies, nil
 }
+
+// fetchTraktUserShows fetches shows from a user endpoint of the connected Trakt account (cached for 5 minutes)
+func fetchTraktUserShows(ctx context.Context, cfg *Config, cacheName, url string) ([]TraktShow, error) {
+	if cfg.TraktClientID == "" || !traktConnected() {
+		return nil, nil
+	}
+
+	// Check cache first
+	cacheKey := getCacheKey(cacheName, cfg.TraktClientID, url)
+	if cached, found := apiCache.Get(cacheKey); found {
+		log.Printf("📦 Using cached %s", strings.ReplaceAll(cacheName, "_", " "))
+		return cached.([]TraktShow), nil
+	}
+
+	shows, err := fetchTraktShows(ctx, cfg, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	apiCache.Set(cacheKey, shows, cacheTTL)
+	return shows, nil
+}
+
+// fetchTraktUserMovies fetches movies from a user endpoint of the connected Trakt account (cached for 5 minutes)
+func fetchTraktUserMovies(ctx context.Context, cfg *Config, cacheName, url string) ([]TraktMovie, error) {
+	if cfg.TraktClientID == "" || !traktConnected() {
+		return nil, nil
+	}
+
+	// Check cache first
+	cacheKey := getCacheKey(cacheName, cfg.TraktClientID, url)
+	if cached, found := apiCache.Get(cacheKey); found {
+		log.Printf("📦 Using cached %s", strings.ReplaceAll(cacheName, "_", " "))
+		return cached.([]TraktMovie), nil
+	}
+
+	movies, err := fetchTraktMovies(ctx, cfg, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	apiCache.Set(cacheKey, movies, cacheTTL)
+	return movies, nil
+}
+
+// fetchTraktRecommendations fetches personal show and movie recommendations, skipping titles already collected
+func fetchTraktRecommendations(ctx context.Context, cfg *Config) ([]TraktShow, []TraktMovie, error) {
+	query := fmt.Sprintf("?ignore_collected=true&limit=%d", cfg.TraktRecommendationsLimit)
+	shows, err := fetchTraktUserShows(ctx, cfg, "trakt_recommended_shows", traktAPIURL+"/recommendations/shows"+query)
+	if err != nil {
+		return nil, nil, err
+	}
+	movies, err := fetchTraktUserMovies(ctx, cfg, "trakt_recommended_movies", traktAPIURL+"/recommendations/movies"+query)
+	if err != nil {
+		return nil, nil, err
+	}
+	return shows, movies, nil
+}
+
+// fetchTraktCalendar fetches the shows and movies on the connected account's personal calendar
+func fetchTraktCalendar(ctx context.Context, cfg *Config, start, end time.Time) ([]TraktShow, []TraktMovie, error) {
+	days := int(end.Sub(start).Hours()/24) + 1
+	path := fmt.Sprintf("/%s/%d", start.Format("2006-01-02"), days)
+	shows, err := fetchTraktUserShows(ctx, cfg, "trakt_calendar_shows", traktAPIURL+"/calendars/my/shows"+path)
+	if err != nil {
+		return nil, nil, err
+	}
+	movies, err := fetchTraktUserMovies(ctx, cfg, "trakt_calendar_movies", traktAPIURL+"/calendars/my/movies"+path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return shows, movies, nil
+}
+
+// fetchTraktWatchlist fetches the shows and movies on the connected account's watchlist
+func fetchTraktWatchlist(ctx context.Context, cfg *Config) ([]TraktShow, []TraktMovie, error) {
+	shows, err := fetchTraktUserShows(ctx, cfg, "trakt_watchlist_shows", traktAPIURL+"/sync/watchlist/shows")
+	if err != nil {
+		return nil, nil, err
+	}
+	movies, err := fetchTraktUserMovies(ctx, cfg, "trakt_watchlist_movies", traktAPIURL+"/sync/watchlist/movies")
+	if err != nil {
+		return nil, nil, err
+	}
+	return shows, movies, nil
+}
+
+// traktListPath converts a Trakt list web URL into its API path.
+// Accepts user lists (trakt.tv/users/<user>/lists/<slug>) and official lists (trakt.tv/lists/<id>).
+func traktListPath(listURL string) (string, error) {
+	u, err := url.Parse(listURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid Trakt list URL %q: %w", listURL, err)
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if strings.HasSuffix(parts[0], "trakt.tv") {
+		parts = parts[1:] // URL pasted without scheme
+	}
+	switch {
+	case len(parts) >= 4 && parts[0] == "users" && parts[2] == "lists":
+		return fmt.Sprintf("/users/%s/lists/%s", parts[1], parts[3]), nil
+	case len(parts) >= 2 && parts[0] == "lists":
+		return "/lists/" + parts[1], nil
+	}
+	return "", fmt.Errorf("unsupported Trakt list URL %q (expected trakt.tv/users/<user>/lists/<list> or trakt.tv/lists/<id>)", listURL)
+}
+
+// fetchTraktList fetches the shows and movies of a custom Trakt list, up to list.Limit of each (cached for 5 minutes)
+func fetchTraktList(ctx context.Context, cfg *Config, list TraktList) (TraktListSection, error) {
+	section := TraktListSection{Heading: list.Heading}
+	if cfg.TraktClientID == "" {
+		return section, nil
+	}
+
+	path, err := traktListPath(list.URL)
+	if err != nil {
+		return section, err
+	}
+	limit := list.Limit
+	if limit <= 0 {
+		limit = DefaultTraktListLimit
+	}
+
+	// Check cache first
+	cacheKey := getCacheKey("trakt_list", cfg.TraktClientID, path, limit)
+	if cached, found := apiCache.Get(cacheKey); found {
+		log.Printf("📦 Using cached Trakt list %s", list.Heading)
+		cached := cached.(TraktListSection)
+		cached.Heading = list.Heading
+		return cached, nil
+	}
+
+	query := fmt.Sprintf("?limit=%d", limit)
+	if section.Shows, err = fetchTraktShows(ctx, cfg, traktAPIURL+path+"/items/show"+query, nil); err != nil {
+		return section, err
+	}
+	if section.Movies, err = fetchTraktMovies(ctx, cfg, traktAPIURL+path+"/items/movie"+query, nil); err != nil {
+		return section, err
+	}
+
+	apiCache.Set(cacheKey, section, cacheTTL)
+	return section, nil
+}
+
+// watchlistNowAvailable keeps the watchlist titles that were downloaded during the newsletter period
+func watchlistNowAvailable(shows []TraktShow, movies []TraktMovie, downloadedEpisodes []Episode, downloadedMovies []Movie) ([]TraktShow, []TraktMovie) {
+	downloaded := make(map[string]bool)
+	for _, ep := range downloadedEpisodes {
+		if ep.IMDBID != "" {
+			downloaded["imdb:"+ep.IMDBID] = true
+		}
+		if ep.TvdbID > 0 {
+			downloaded[fmt.Sprintf("tvdb:%d", ep.TvdbID)] = true
+		}
+	}
+	for _, m := range downloadedMovies {
+		if m.IMDBID != "" {
+			downloaded["imdb:"+m.IMDBID] = true
+		}
+		if m.TmdbID > 0 {
+			downloaded[fmt.Sprintf("tmdb:%d", m.TmdbID)] = true
+		}
+	}
+
+	availableShows := []TraktShow{}
+	for _, show := range shows {
+		if isShowInLibrary(downloaded, show.IMDBID, show.TVDBID) {
+			availableShows = append(availableShows, show)
+		}
+	}
+	availableMovies := []TraktMovie{}
+	for _, movie := range movies {
+		if isMovieInLibrary(downloaded, movie.IMDBID, movie.TMDBID) {
+			availableMovies = append(availableMovies, movie)
+		}
+	}
+	return availableShows, availableMovies
+}
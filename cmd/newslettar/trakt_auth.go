@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// Trakt OAuth device-code flow (https://trakt.docs.apiary.io/#reference/authentication-devices):
+// the web UI asks for a user code, the user enters it on trakt.tv/activate, and the UI polls
+// until Trakt hands out an access token. Tokens are stored in traktTokenDir, which Docker setups
+// mount as a volume so the login survives container updates, and refreshed automatically.
+
+const (
+	traktAPIURL      = "https://api.trakt.tv"
+	traktTokenDir    = "trakt"
+	traktTokenFile   = "trakt/token.json"
+	traktRedirectURI = "urn:ietf:wg:oauth:2.0:oob"
+)
+
+// traktToken is the persisted OAuth token of the connected Trakt account
+type traktToken struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	Username     string    `json:"username"`
+}
+
+// traktTokenResponse is the response of /oauth/device/token and /oauth/token
+type traktTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"` // seconds
+	CreatedAt    int64  `json:"created_at"` // unix timestamp
+}
+
+// toToken converts a token response into the persisted form
+func (r traktTokenResponse) toToken() *traktToken {
+	created := time.Unix(r.CreatedAt, 0)
+	if r.CreatedAt == 0 {
+		created = time.Now()
+	}
+	return &traktToken{
+		AccessToken:  r.AccessToken,
+		RefreshToken: r.RefreshToken,
+		ExpiresAt:    created.Add(time.Duration(r.ExpiresIn) * time.Second),
+	}
+}
+
+// traktDeviceCode is the response of /oauth/device/code
+type traktDeviceCode struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURL string `json:"verification_url"`
+	ExpiresIn       int    `json:"expires_in"` // seconds
+	Interval        int    `json:"interval"`   // seconds between polls
+}
+
+// Device code of the login in progress (kept server side, the UI only sees the user code)
+var (
+	traktTokenMu      sync.Mutex
+	traktPendingCode  string
+	traktPendingUntil time.Time
+)
+
+// loadTraktToken reads the stored token, or returns nil when no account is connected
+func loadTraktToken() *traktToken {
+	data, err := os.ReadFile(traktTokenFile)
+	if err != nil {
+		return nil
+	}
+	var token traktToken
+	if err := json.Unmarshal(data, &token); err != nil || token.AccessToken == "" {
+		return nil
+	}
+	return &token
+}
+
+// saveTraktToken persists the token with restricted permissions (owner read/write only)
+func saveTraktToken(token *traktToken) error {
+	data, err := json.MarshalIndent(token, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(traktTokenDir, 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(traktTokenFile, data, 0600)
+}
+
+// traktConnected reports whether a Trakt account is connected
+func traktConnected() bool {
+	traktTokenMu.Lock()
+	defer traktTokenMu.Unlock()
+	return loadTraktToken() != nil
+}
+
+// traktPost sends a JSON POST to the Trakt API and decodes a 200/201 response into v.
+// It returns the HTTP status code because the device flow reports its state through it.
+func traktPost(ctx context.Context, path string, body, v interface{}) (int, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", traktAPIURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return resp.StatusCode, fmt.Errorf("trakt API error (status %d)", resp.StatusCode)
+	}
+	if v == nil {
+		return resp.StatusCode, nil
+	}
+	return resp.StatusCode, json.NewDecoder(resp.Body).Decode(v)
+}
+
+// startTraktDeviceLogin requests a new device code and remembers it for polling
+func startTraktDeviceLogin(ctx context.Context, cfg *Config) (*traktDeviceCode, error) {
+	if cfg.TraktClientID == "" || cfg.TraktClientSecret == "" {
+		return nil, fmt.Errorf("Trakt Client ID and Client Secret must be saved first")
+	}
+
+	var code traktDeviceCode
+	if _, err := traktPost(ctx, "/oauth/device/code", map[string]string{"client_id": cfg.TraktClientID}, &code); err != nil {
+		return nil, err
+	}
+
+	traktTokenMu.Lock()
+	traktPendingCode = code.DeviceCode
+	traktPendingUntil = time.Now().Add(time.Duration(code.ExpiresIn) * time.Second)
+	traktTokenMu.Unlock()
+
+	log.Printf("🔑 Trakt login started - enter code %s at %s", code.UserCode, code.VerificationURL)
+	return &code, nil
+}
+
+// pollTraktDeviceLogin checks whether the user approved the pending device code.
+// It returns "pending", "slow_down", "authorized", "expired", "denied" or "error".
+func pollTraktDeviceLogin(ctx context.Context, cfg *Config) (string, error) {
+	traktTokenMu.Lock()
+	deviceCode, until := traktPendingCode, traktPendingUntil
+	traktTokenMu.Unlock()
+
+	if deviceCode == "" || time.Now().After(until) {
+		return "expired", nil
+	}
+
+	var resp traktTokenResponse
+	status, err := traktPost(ctx, "/oauth/device/token", map[string]string{
+		"code":          deviceCode,
+		"client_id":     cfg.TraktClientID,
+		"client_secret": cfg.TraktClientSecret,
+	}, &resp)
+
+	switch status {
+	case http.StatusOK:
+		token := resp.toToken()
+		token.Username = fetchTraktUsername(ctx, cfg, token.AccessToken)
+
+		traktTokenMu.Lock()
+		traktPendingCode = ""
+		err := saveTraktToken(token)
+		traktTokenMu.Unlock()
+		if err != nil {
+			return "error", fmt.Errorf("failed to save Trakt token: %w", err)
+		}
+		log.Printf("✅ Trakt account connected (%s)", token.Username)
+		return "authorized", nil
+	case http.StatusBadRequest:
+		return "pending", nil
+	case http.StatusTooManyRequests:
+		return "slow_down", nil
+	case http.StatusGone:
+		return "expired", nil
+	case http.StatusTeapot:
+		return "denied", nil
+	}
+	if err == nil {
+		err = fmt.Errorf("unexpected Trakt response (status %d)", status)
+	}
+	return "error", err
+}
+
+// disconnectTrakt revokes the stored token (best effort) and deletes it
+func disconnectTrakt(ctx context.Context, cfg *Config) error {
+	traktTokenMu.Lock()
+	defer traktTokenMu.Unlock()
+
+	token := loadTraktToken()
+	if token == nil {
+		return nil
+	}
+	if _, err := traktPost(ctx, "/oauth/revoke", map[string]string{
+		"token":         token.AccessToken,
+		"client_id":     cfg.TraktClientID,
+		"client_secret": cfg.TraktClientSecret,
+	}, nil); err != nil {
+		log.Printf("⚠️  Failed to revoke Trakt token: %v", err)
+	}
+	if err := os.Remove(traktTokenFile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	log.Println("🔌 Trakt account disconnected")
+	return nil
+}
+
+// getTraktAccessToken returns a valid access token, refreshing it when it expires within a day
+func getTraktAccessToken(ctx context.Context, cfg *Config) (string, error) {
+	traktTokenMu.Lock()
+	defer traktTokenMu.Unlock()
+
+	token := loadTraktToken()
+	if token == nil {
+		return "", fmt.Errorf("no Trakt account connected")
+	}
+	if time.Until(token.ExpiresAt) > 24*time.Hour {
+		return token.AccessToken, nil
+	}
+
+	log.Println("🔄 Refreshing Trakt access token...")
+	var resp traktTokenResponse
+	if _, err := traktPost(ctx, "/oauth/token", map[string]string{
+		"refresh_token": token.RefreshToken,
+		"client_id":     cfg.TraktClientID,
+		"client_secret": cfg.TraktClientSecret,
+		"redirect_uri":  traktRedirectURI,
+		"grant_type":    "refresh_token",
+	}, &resp); err != nil {
+		// The current token may still work until it actually expires
+		if time.Now().Before(token.ExpiresAt) {
+			log.Printf("⚠️  Failed to refresh Trakt token: %v", err)
+			return token.AccessToken, nil
+		}
+		return "", fmt.Errorf("Trakt token expired and refresh failed: %w", err)
+	}
+
+	refreshed := resp.toToken()
+	refreshed.Username = token.Username
+	if err := saveTraktToken(refreshed); err != nil {
+		log.Printf("⚠️  Failed to save refreshed Trakt token: %v", err)
+	}
+	return refreshed.AccessToken, nil
+}
+
+// fetchTraktUsername returns the username of the account behind an access token
+func fetchTraktUsername(ctx context.Context, cfg *Config, accessToken string) string {
+	req, err := http.NewRequestWithContext(ctx, "GET", traktAPIURL+"/users/settings", nil)
+	if err != nil {
+		return ""
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("trakt-api-version", "2")
+	req.Header.Set("trakt-api-key", cfg.TraktClientID)
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return ""
+	}
+	defer resp.Body.Close()
+
+	var settings struct {
+		User struct {
+			Username string `json:"username"`
+		} `json:"user"`
+	}
+	if resp.StatusCode != http.StatusOK || json.NewDecoder(resp.Body).Decode(&settings) != nil {
+		return ""
+	}
+	return settings.User.Username
+}
@@ -0,0 +1,36 @@
+package main
+
+import "testing"
+
+func TestTraktListPath(t *testing.T) {
+	tests := []struct {
+		url     string
+		want    string
+		wantErr bool
+	}{
+		{url: "https://trakt.tv/users/alice/lists/favourites", want: "/users/alice/lists/favourites"},
+		{url: "https://trakt.tv/users/alice/lists/favourites/", want: "/users/alice/lists/favourites"},
+		{url: "https://trakt.tv/users/alice/lists/favourites?sort=rank,asc", want: "/users/alice/lists/favourites"},
+		{url: "trakt.tv/users/alice/lists/favourites", want: "/users/alice/lists/favourites"},
+		{url: "https://trakt.tv/lists/1234", want: "/lists/1234"},
+		{url: "https://trakt.tv/users/alice", wantErr: true},
+		{url: "https://trakt.tv/users/alice/watchlist", wantErr: true},
+		{url: "https://trakt.tv/shows/trending", wantErr: true},
+		{url: "", wantErr: true},
+		{url: "%zz", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			got, err := traktListPath(tt.url)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("traktListPath(%q) = %q, want an error", tt.url, got)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("traktListPath(%q) = %q, %v, want %q", tt.url, got, err, tt.want)
+			}
+		})
+	}
+}
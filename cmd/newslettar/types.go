@@ -75,20 +75,76 @@ func (c *APICache) StartPeriodicCleanup(interval time.Duration) {
 	}()
 }
 
+// ArrInstance is a single named Sonarr or Radarr server
+type ArrInstance struct {
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	APIKey string `json:"api_key"`
+}
+
+// TraktList is a custom newsletter section backed by a public or official Trakt list
+type TraktList struct {
+	Heading string `json:"heading"`
+	URL     string `json:"url"` // e.g. https://trakt.tv/users/<user>/lists/<slug> or https://trakt.tv/lists/<id>
+	Limit   int    `json:"limit"`
+}
+
+// configured reports whether both URL and API key are set
+func (i ArrInstance) configured() bool {
+	return i.URL != "" && i.APIKey != ""
+}
+
 // Config structures
 type Config struct {
 	SonarrURL                   string
 	SonarrAPIKey                string
 	RadarrURL                   string
 	RadarrAPIKey                string
+	SonarrInstances             []ArrInstance // Primary instance first, then SONARR_2_* .. SONARR_N_*
+	RadarrInstances             []ArrInstance // Primary instance first, then RADARR_2_* .. RADARR_N_*
+	ReadarrInstances            []ArrInstance // Primary instance first, then READARR_2_* .. READARR_N_*
 	TraktClientID               string
+	TraktClientSecret           string // Only needed for the OAuth device login
+	TMDBAPIKey                  string // Optional: posters for Trakt items
+	JellyfinURL                 string
+	JellyfinAPIKey              string
+	JellyfinServerType          string // "jellyfin" or "emby"
+	PlexURL                     string
+	PlexToken                   string
+	LidarrURL                   string
+	LidarrAPIKey                string
+	OverseerrURL                string
+	OverseerrAPIKey             string
+	TautulliURL                 string
+	TautulliAPIKey              string
+	BazarrURL                   string
+	BazarrAPIKey                string
 	SMTPHost                    string
 	SMTPPort                    string
 	SMTPUser                    string
 	SMTPPass                    string
+	SMTPSecurity                string // "auto", "starttls-required", "starttls-opportunistic", "implicit-tls" or "none"
+	SMTPCAFile                  string // PEM file with extra CA certificates for self-hosted relays
+	SMTPTLSSkipVerify           bool
 	FromEmail                   string
 	FromName                    string
 	ToEmails                    []string
+	SlackWebhookURL             string   // Optional: also post the newsletter to a Slack/Mattermost incoming webhook
+	DiscordWebhookURL           string   // Optional: also post the newsletter to a Discord channel
+	TelegramBotToken            string   // Optional: also post the newsletter to Telegram chats
+	TelegramChatIDs             []string // Chat IDs or @channel usernames
+	TelegramAPIURL              string   // Bot API base URL
+	MatrixHomeserverURL         string   // Optional: push a summary to a Matrix room
+	MatrixAccessToken           string
+	MatrixRoomID                string
+	NtfyURL                     string // Optional: push a summary to an ntfy topic
+	NtfyTopic                   string
+	NtfyToken                   string // Only needed for protected topics
+	GotifyURL                   string // Optional: push a summary to Gotify
+	GotifyToken                 string // Application token
+	WebhookURL                  string // Optional: POST the full newsletter data as JSON
+	WebhookSecret               string // HMAC-SHA256 signing secret for the webhook
+	PublicURL                   string // Externally reachable web UI URL, used for links to the newsletter archive
 	Timezone                    string
 	ScheduleDay                 string
 	ScheduleTime                string
@@ -109,6 +165,36 @@ type Config struct {
 	TraktWatchedSeriesLimit     int
 	TraktAnticipatedMoviesLimit int
 	TraktWatchedMoviesLimit     int
+	ShowServerMostWatched       bool
+	ShowServerRecentlyAdded     bool
+	ServerMostWatchedLimit      int
+	ServerRecentlyAddedLimit    int
+	ShowMusic                   bool
+	ShowBooks                   bool
+	ShowPendingRequests         bool
+	PendingRequestsLimit        int
+	ShowServerStats             bool
+	ShowSubtitles               bool
+	ShowQueue                   bool
+	QueueLimit                  int
+	ShowMissing                 bool
+	MissingLimit                int
+	ServerStatsLimit            int
+	ShowTraktWatchlist          bool
+	ShowTraktRecommendations    bool
+	ShowTraktCalendar           bool
+	TraktRecommendationsLimit   int
+	TraktCalendarLimit          int
+	TraktLists                  []TraktList
+	FeedItems                   bool // Publish every downloaded series and movie as its own feed entry
+	EmailAttachICS              bool // Attach the upcoming releases as an .ics calendar to the email
+	EmailInlinePosters          bool // Embed posters in the email as inline images instead of remote URLs
+	// Admin digest
+	AdminEmails        []string
+	AdminScheduleType  string // "daily" or "weekly"
+	AdminScheduleDay   string
+	AdminScheduleTime  string
+	DiskWarningPercent int
 	// Performance tuning
 	APIPageSize     int
 	MaxRetries      int
@@ -135,6 +221,17 @@ type Config struct {
 	WatchedSeriesHeading      string
 	AnticipatedMoviesHeading  string
 	WatchedMoviesHeading      string
+	MostWatchedHeading        string
+	RecentlyAddedHeading      string
+	MusicHeading              string
+	BooksHeading              string
+	PendingRequestsHeading    string
+	ServerStatsHeading        string
+	QueueHeading              string
+	MissingHeading            string
+	TraktWatchlistHeading     string
+	RecommendationsHeading    string
+	TraktCalendarHeading      string
 	FooterText                string
 	// Customizable email strings (monthly schedule)
 	MonthlyEmailTitle                string
@@ -153,32 +250,78 @@ type Config struct {
 
 // Minimal structs - only fields we actually need (reduces memory & JSON parsing time)
 type Episode struct {
-	SeriesTitle    string
-	SeasonNum      int
-	EpisodeNum     int
-	Title          string
-	AirDate        string
-	Downloaded     bool
-	PosterURL      string
-	IMDBID         string
-	TvdbID         int
-	Overview       string
-	SeriesOverview string
-	Monitored      bool
-	Rating         float64
+	SeriesTitle    string   `json:"series_title"`
+	SeasonNum      int      `json:"season_number"`
+	EpisodeNum     int      `json:"episode_number"`
+	Title          string   `json:"title"`
+	AirDate        string   `json:"air_date"`
+	AirDateUTC     string   `json:"air_date_utc,omitempty"` // Exact air time (RFC 3339) from the Sonarr calendar
+	Runtime        int      `json:"runtime,omitempty"`      // Minutes, from the Sonarr calendar
+	Downloaded     bool     `json:"downloaded"`
+	PosterURL      string   `json:"poster_url,omitempty"`
+	IMDBID         string   `json:"imdb_id,omitempty"`
+	TvdbID         int      `json:"tvdb_id,omitempty"`
+	Overview       string   `json:"overview,omitempty"`
+	SeriesOverview string   `json:"-"`
+	Monitored      bool     `json:"monitored"`
+	Rating         float64  `json:"rating,omitempty"`
+	Instances      []string `json:"instances,omitempty"` // Names of the Sonarr instances that reported this episode
+	// Sonarr IDs from the first instance that reported this episode (used to match Bazarr)
+	SonarrSeriesID   int      `json:"sonarr_series_id,omitempty"`
+	SonarrEpisodeID  int      `json:"sonarr_episode_id,omitempty"`
+	Subtitles        []string `json:"subtitles,omitempty"`         // Bazarr: subtitle languages available (e.g. "EN", "FR")
+	MissingSubtitles []string `json:"missing_subtitles,omitempty"` // Bazarr: wanted subtitle languages that are still missing
 }
 
 type Movie struct {
-	Title       string
-	Year        int
-	ReleaseDate string
-	Downloaded  bool
-	PosterURL   string
-	IMDBID      string
-	TmdbID      int
-	Overview    string
-	Monitored   bool
-	Rating      float64
+	Title       string   `json:"title"`
+	Year        int      `json:"year"`
+	ReleaseDate string   `json:"release_date,omitempty"`
+	Downloaded  bool     `json:"downloaded"`
+	PosterURL   string   `json:"poster_url,omitempty"`
+	IMDBID      string   `json:"imdb_id,omitempty"`
+	TmdbID      int      `json:"tmdb_id,omitempty"`
+	Overview    string   `json:"overview,omitempty"`
+	Monitored   bool     `json:"monitored"`
+	Rating      float64  `json:"rating,omitempty"`
+	Instances   []string `json:"instances,omitempty"`    // Names of the Radarr instances that reported this movie
+	PlexURL     string   `json:"plex_url,omitempty"`     // app.plex.tv deep link when the movie is on the Plex server
+	RequestedBy []string `json:"requested_by,omitempty"` // Overseerr/Jellyseerr users who requested this movie
+	RadarrID    int      `json:"radarr_id,omitempty"`    // Radarr movie ID from the first instance that reported this movie (used to match Bazarr)
+	// Bazarr subtitle languages, same as Episode
+	Subtitles        []string `json:"subtitles,omitempty"`
+	MissingSubtitles []string `json:"missing_subtitles,omitempty"`
+}
+
+// Album is a Lidarr album, either imported during the period or releasing soon
+type Album struct {
+	Title         string
+	Artist        string
+	AlbumType     string // "Album", "EP", "Single", ...
+	ReleaseDate   string
+	CoverURL      string
+	TrackCount    int
+	Overview      string
+	MusicBrainzID string // Release group ID, used for the MusicBrainz link
+	Monitored     bool
+	Downloaded    bool
+}
+
+// Book is a Readarr book (ebook or audiobook), either imported during the period or releasing soon
+type Book struct {
+	Title         string
+	Author        string
+	SeriesTitle   string // e.g. "The Expanse (#3)"
+	ReleaseDate   string
+	CoverURL      string
+	Overview      string
+	PageCount     int
+	Rating        float64
+	URL           string // Metadata link reported by Readarr (Goodreads)
+	ForeignBookID string
+	Monitored     bool
+	Downloaded    bool
+	Instances     []string // Names of the Readarr instances that reported this book
 }
 
 // For Sonarr calendar response (nested series data)
@@ -187,9 +330,11 @@ type CalendarEpisode struct {
 	EpisodeNumber int    `json:"episodeNumber"`
 	Title         string `json:"title"`
 	AirDate       string `json:"airDate"`
+	AirDateUtc    string `json:"airDateUtc"`
 	Overview      string `json:"overview"`
 	Series        struct {
 		Title     string `json:"title"`
+		Runtime   int    `json:"runtime"`
 		TvdbId    int    `json:"tvdbId"`
 		ImdbId    string `json:"imdbId"`
 		Overview  string `json:"overview"`
@@ -230,36 +375,154 @@ type CalendarMovie struct {
 }
 
 type SeriesGroup struct {
-	SeriesTitle  string
-	PosterURL    string
-	Episodes     []Episode
-	IMDBID       string
-	TvdbID       int
-	Overview     string
-	SeriesRating float64
+	SeriesTitle  string    `json:"title"`
+	PosterURL    string    `json:"poster_url,omitempty"`
+	Episodes     []Episode `json:"episodes"`
+	IMDBID       string    `json:"imdb_id,omitempty"`
+	TvdbID       int       `json:"tvdb_id,omitempty"`
+	Overview     string    `json:"overview,omitempty"`
+	SeriesRating float64   `json:"rating,omitempty"`
+	Instances    []string  `json:"instances,omitempty"`
+	PlexURL      string    `json:"plex_url,omitempty"`     // app.plex.tv deep link when the series is on the Plex server
+	RequestedBy  []string  `json:"requested_by,omitempty"` // Overseerr/Jellyseerr users who requested this series
 }
 
 type TraktShow struct {
-	Title       string
-	Year        int
-	ImageURL    string
-	Overview    string
-	ReleaseDate string
-	Network     string
-	IMDBID      string
-	Rating      float64
-	InLibrary   bool
+	Title       string  `json:"title"`
+	Year        int     `json:"year,omitempty"`
+	ImageURL    string  `json:"poster_url,omitempty"`
+	Overview    string  `json:"overview,omitempty"`
+	ReleaseDate string  `json:"release_date,omitempty"`
+	Network     string  `json:"network,omitempty"`
+	IMDBID      string  `json:"imdb_id,omitempty"`
+	TVDBID      int     `json:"tvdb_id,omitempty"`
+	TMDBID      int     `json:"tmdb_id,omitempty"`
+	Rating      float64 `json:"rating,omitempty"`
+	InLibrary   bool    `json:"in_library"`
+	PlexURL     string  `json:"plex_url,omitempty"`
+	BackdropURL string  `json:"backdrop_url,omitempty"`
 }
 
 type TraktMovie struct {
+	Title       string  `json:"title"`
+	Year        int     `json:"year,omitempty"`
+	ImageURL    string  `json:"poster_url,omitempty"`
+	Overview    string  `json:"overview,omitempty"`
+	ReleaseDate string  `json:"release_date,omitempty"`
+	IMDBID      string  `json:"imdb_id,omitempty"`
+	TMDBID      int     `json:"tmdb_id,omitempty"`
+	Rating      float64 `json:"rating,omitempty"`
+	InLibrary   bool    `json:"in_library"`
+	PlexURL     string  `json:"plex_url,omitempty"`
+	BackdropURL string  `json:"backdrop_url,omitempty"`
+}
+
+// TraktListSection is the content of one custom Trakt list section
+type TraktListSection struct {
+	Heading string       `json:"heading"`
+	Shows   []TraktShow  `json:"shows"`
+	Movies  []TraktMovie `json:"movies"`
+}
+
+// MediaServerItem is a movie or series reported by a media server (Jellyfin/Emby or Plex)
+type MediaServerItem struct {
+	Title    string
+	Type     string // "Movie" or "Series"
+	Year     int
+	Overview string
+	IMDBID   string
+	Episodes int    // Number of new episodes (recently added series only)
+	Plays    int    // Plays during the newsletter period (most watched only)
+	URL      string // Deep link into the media server's web app (Plex only)
+}
+
+// PendingRequest is an approved Overseerr/Jellyseerr request that is not available yet
+type PendingRequest struct {
 	Title       string
+	Type        string // "Movie" or "Series"
+	Year        int
+	PosterURL   string
+	RequestedBy string
+	RequestedAt string
+	Status      string // "Approved", "Processing" or "Partially available"
+}
+
+// QueueItem is an episode or movie currently in a Sonarr/Radarr download queue
+type QueueItem struct {
+	Title     string // Series or movie title
+	Subtitle  string // "S01E02 • Episode title" for episodes
+	Type      string // "Episode" or "Movie"
+	Year      int
+	PosterURL string
+	Progress  int    // Percent downloaded
+	ETA       string // Formatted time left, e.g. "1h 20m" (downloading items only)
+	Status    string // "downloading", "queued", "paused", "importing", ...
+	Instances []string
+}
+
+// MissingItem is a monitored episode or movie that has aired or been released but has no file
+type MissingItem struct {
+	Title       string // Series or movie title
+	Subtitle    string // "S01E02 • Episode title" for episodes
+	Type        string // "Episode" or "Movie"
 	Year        int
-	ImageURL    string
-	Overview    string
-	ReleaseDate string
-	IMDBID      string
-	Rating      float64
-	InLibrary   bool
+	ReleaseDate string // Air date for episodes, home release date for movies
+	PosterURL   string
+	Instances   []string
+}
+
+// ServerStatItem is one row of the Tautulli server stats (a movie, show or platform)
+type ServerStatItem struct {
+	Title     string
+	Year      int
+	Plays     int
+	WatchTime string // Formatted, e.g. "3h 20m"
+}
+
+// ServerStats are the Tautulli home stats for the newsletter period
+type ServerStats struct {
+	TopMovies      []ServerStatItem
+	TopShows       []ServerStatItem
+	TopPlatforms   []ServerStatItem
+	TotalWatchTime string // Formatted, e.g. "2d 5h"
+}
+
+// HealthIssue is one warning or error reported by an *arr health check
+type HealthIssue struct {
+	Type    string // "notice", "warning" or "error"
+	Source  string // Name of the check, e.g. "IndexerStatusCheck"
+	Message string
+	WikiURL string
+}
+
+// DiskReport is the free space of one disk as seen by an *arr instance
+type DiskReport struct {
+	Path        string
+	Free        string // Formatted, e.g. "120.5 GB"
+	Total       string
+	FreePercent int
+	Low         bool // Free space is below DISK_WARNING_PERCENT
+}
+
+// AdminInstanceReport is the health of one Sonarr/Radarr/Readarr/Lidarr instance
+type AdminInstanceReport struct {
+	Name      string
+	Service   string // "Sonarr", "Radarr", "Readarr" or "Lidarr"
+	Version   string
+	Reachable bool
+	Error     string // Set when the instance could not be reached
+	Issues    []HealthIssue
+	Disks     []DiskReport
+	Problems  int // Warnings, errors and low disks (1 when unreachable)
+}
+
+// AdminDigestData is the template data of the admin health digest
+type AdminDigestData struct {
+	GeneratedAt        string
+	Instances          []AdminInstanceReport
+	ProblemCount       int // Unreachable instances, warnings, errors and low disks
+	DiskWarningPercent int
+	DarkMode           bool
 }
 
 type NewsletterData struct {
@@ -275,6 +538,25 @@ type NewsletterData struct {
 	TraktWatchedSeries     []TraktShow
 	TraktAnticipatedMovies []TraktMovie
 	TraktWatchedMovies     []TraktMovie
+	ServerMostWatched      []MediaServerItem
+	ServerRecentlyAdded    []MediaServerItem
+	UpcomingAlbums         []Album
+	DownloadedAlbums       []Album
+	UpcomingBooks          []Book
+	DownloadedBooks        []Book
+	PendingRequests        []PendingRequest
+	ServerStats            *ServerStats
+	QueueItems             []QueueItem
+	MissingItems           []MissingItem
+	MissingTotal           int // Number of missing items before the limit was applied
+	// Personal Trakt sections (connected account only)
+	TraktWatchlistShows    []TraktShow
+	TraktWatchlistMovies   []TraktMovie
+	TraktRecommendedShows  []TraktShow
+	TraktRecommendedMovies []TraktMovie
+	TraktCalendarShows     []TraktShow
+	TraktCalendarMovies    []TraktMovie
+	TraktListSections      []TraktListSection
 	// Customizable strings
 	EmailTitle                string
 	EmailIntro                string
@@ -292,9 +574,21 @@ type NewsletterData struct {
 	WatchedSeriesHeading      string
 	AnticipatedMoviesHeading  string
 	WatchedMoviesHeading      string
+	MostWatchedHeading        string
+	RecentlyAddedHeading      string
+	MusicHeading              string
+	BooksHeading              string
+	PendingRequestsHeading    string
+	ServerStatsHeading        string
+	QueueHeading              string
+	MissingHeading            string
+	TraktWatchlistHeading     string
+	RecommendationsHeading    string
+	TraktCalendarHeading      string
 	FooterText                string
 	// Template display options (needed for template rendering)
 	ShowPosters                bool
+	ShowTraktPosters           bool // Posters for Trakt items (requires a TMDB API key)
 	ShowDownloaded             bool
 	ShowSeriesOverview         bool
 	ShowEpisodeOverview        bool
@@ -304,41 +598,106 @@ type NewsletterData struct {
 	ShowTraktWatchedSeries     bool
 	ShowTraktAnticipatedMovies bool
 	ShowTraktWatchedMovies     bool
+	ShowInstanceLabels         bool // Only label items when more than one Sonarr/Radarr/Readarr instance is configured
 }
 
 type WebConfig struct {
-	SonarrURL                   string `json:"sonarr_url"`
-	SonarrAPIKey                string `json:"sonarr_api_key"`
-	RadarrURL                   string `json:"radarr_url"`
-	RadarrAPIKey                string `json:"radarr_api_key"`
-	TraktClientID               string `json:"trakt_client_id"`
-	SMTPHost                    string `json:"smtp_host"`
-	SMTPPort                    string `json:"smtp_port"`
-	SMTPUser                    string `json:"smtp_user"`
-	SMTPPass                    string `json:"smtp_pass"`
-	FromEmail                   string `json:"from_email"`
-	FromName                    string `json:"from_name"`
-	ToEmails                    string `json:"to_emails"`
-	Timezone                    string `json:"timezone"`
-	ScheduleDay                 string `json:"schedule_day"`
-	ScheduleTime                string `json:"schedule_time"`
-	ScheduleType                string `json:"schedule_type"`
-	ScheduleDayOfMonth          string `json:"schedule_day_of_month"`
-	ShowPosters                 string `json:"show_posters"`
-	ShowDownloaded              string `json:"show_downloaded"`
-	ShowSeriesOverview          string `json:"show_series_overview"`
-	ShowEpisodeOverview         string `json:"show_episode_overview"`
-	ShowUnmonitored             string `json:"show_unmonitored"`
-	ShowSeriesRatings           string `json:"show_series_ratings"`
-	DarkMode                    string `json:"dark_mode"`
-	ShowTraktAnticipatedSeries  string `json:"show_trakt_anticipated_series"`
-	ShowTraktWatchedSeries      string `json:"show_trakt_watched_series"`
-	ShowTraktAnticipatedMovies  string `json:"show_trakt_anticipated_movies"`
-	ShowTraktWatchedMovies      string `json:"show_trakt_watched_movies"`
-	TraktAnticipatedSeriesLimit string `json:"trakt_anticipated_series_limit"`
-	TraktWatchedSeriesLimit     string `json:"trakt_watched_series_limit"`
-	TraktAnticipatedMoviesLimit string `json:"trakt_anticipated_movies_limit"`
-	TraktWatchedMoviesLimit     string `json:"trakt_watched_movies_limit"`
+	SonarrName                  string        `json:"sonarr_name"`
+	SonarrURL                   string        `json:"sonarr_url"`
+	SonarrAPIKey                string        `json:"sonarr_api_key"`
+	SonarrInstances             []ArrInstance `json:"sonarr_instances"` // Additional instances only
+	RadarrName                  string        `json:"radarr_name"`
+	RadarrURL                   string        `json:"radarr_url"`
+	RadarrAPIKey                string        `json:"radarr_api_key"`
+	RadarrInstances             []ArrInstance `json:"radarr_instances"` // Additional instances only
+	ReadarrName                 string        `json:"readarr_name"`
+	ReadarrURL                  string        `json:"readarr_url"`
+	ReadarrAPIKey               string        `json:"readarr_api_key"`
+	ReadarrInstances            []ArrInstance `json:"readarr_instances"` // Additional instances only
+	TraktClientID               string        `json:"trakt_client_id"`
+	TraktClientSecret           string        `json:"trakt_client_secret"`
+	TMDBAPIKey                  string        `json:"tmdb_api_key"`
+	TraktLists                  []TraktList   `json:"trakt_lists"`
+	JellyfinURL                 string        `json:"jellyfin_url"`
+	JellyfinAPIKey              string        `json:"jellyfin_api_key"`
+	JellyfinServerType          string        `json:"jellyfin_server_type"`
+	PlexURL                     string        `json:"plex_url"`
+	PlexToken                   string        `json:"plex_token"`
+	LidarrURL                   string        `json:"lidarr_url"`
+	LidarrAPIKey                string        `json:"lidarr_api_key"`
+	OverseerrURL                string        `json:"overseerr_url"`
+	OverseerrAPIKey             string        `json:"overseerr_api_key"`
+	TautulliURL                 string        `json:"tautulli_url"`
+	TautulliAPIKey              string        `json:"tautulli_api_key"`
+	BazarrURL                   string        `json:"bazarr_url"`
+	BazarrAPIKey                string        `json:"bazarr_api_key"`
+	SMTPHost                    string        `json:"smtp_host"`
+	SMTPPort                    string        `json:"smtp_port"`
+	SMTPUser                    string        `json:"smtp_user"`
+	SMTPPass                    string        `json:"smtp_pass"`
+	SMTPSecurity                string        `json:"smtp_security"`
+	SMTPCAFile                  string        `json:"smtp_ca_file"`
+	SMTPTLSSkipVerify           string        `json:"smtp_tls_skip_verify"`
+	FromEmail                   string        `json:"from_email"`
+	FromName                    string        `json:"from_name"`
+	ToEmails                    string        `json:"to_emails"`
+	SlackWebhookURL             string        `json:"slack_webhook_url"`
+	DiscordWebhookURL           string        `json:"discord_webhook_url"`
+	TelegramBotToken            string        `json:"telegram_bot_token"`
+	TelegramChatIDs             string        `json:"telegram_chat_ids"`
+	TelegramAPIURL              string        `json:"telegram_api_url"`
+	MatrixHomeserverURL         string        `json:"matrix_homeserver_url"`
+	MatrixAccessToken           string        `json:"matrix_access_token"`
+	MatrixRoomID                string        `json:"matrix_room_id"`
+	NtfyURL                     string        `json:"ntfy_url"`
+	NtfyTopic                   string        `json:"ntfy_topic"`
+	NtfyToken                   string        `json:"ntfy_token"`
+	GotifyURL                   string        `json:"gotify_url"`
+	GotifyToken                 string        `json:"gotify_token"`
+	WebhookURL                  string        `json:"webhook_url"`
+	WebhookSecret               string        `json:"webhook_secret"`
+	PublicURL                   string        `json:"public_url"`
+	Timezone                    string        `json:"timezone"`
+	ScheduleDay                 string        `json:"schedule_day"`
+	ScheduleTime                string        `json:"schedule_time"`
+	ScheduleType                string        `json:"schedule_type"`
+	ScheduleDayOfMonth          string        `json:"schedule_day_of_month"`
+	AdminEmails                 string        `json:"admin_emails"`
+	AdminScheduleType           string        `json:"admin_schedule_type"`
+	AdminScheduleDay            string        `json:"admin_schedule_day"`
+	AdminScheduleTime           string        `json:"admin_schedule_time"`
+	ShowPosters                 string        `json:"show_posters"`
+	ShowDownloaded              string        `json:"show_downloaded"`
+	ShowSeriesOverview          string        `json:"show_series_overview"`
+	ShowEpisodeOverview         string        `json:"show_episode_overview"`
+	ShowUnmonitored             string        `json:"show_unmonitored"`
+	ShowSeriesRatings           string        `json:"show_series_ratings"`
+	DarkMode                    string        `json:"dark_mode"`
+	ShowTraktAnticipatedSeries  string        `json:"show_trakt_anticipated_series"`
+	ShowTraktWatchedSeries      string        `json:"show_trakt_watched_series"`
+	ShowTraktAnticipatedMovies  string        `json:"show_trakt_anticipated_movies"`
+	ShowTraktWatchedMovies      string        `json:"show_trakt_watched_movies"`
+	TraktAnticipatedSeriesLimit string        `json:"trakt_anticipated_series_limit"`
+	TraktWatchedSeriesLimit     string        `json:"trakt_watched_series_limit"`
+	TraktAnticipatedMoviesLimit string        `json:"trakt_anticipated_movies_limit"`
+	TraktWatchedMoviesLimit     string        `json:"trakt_watched_movies_limit"`
+	ShowServerMostWatched       string        `json:"show_server_most_watched"`
+	ShowServerRecentlyAdded     string        `json:"show_server_recently_added"`
+	ServerMostWatchedLimit      string        `json:"server_most_watched_limit"`
+	ServerRecentlyAddedLimit    string        `json:"server_recently_added_limit"`
+	ShowMusic                   string        `json:"show_music"`
+	ShowBooks                   string        `json:"show_books"`
+	ShowPendingRequests         string        `json:"show_pending_requests"`
+	ShowServerStats             string        `json:"show_server_stats"`
+	ShowSubtitles               string        `json:"show_subtitles"`
+	ShowQueue                   string        `json:"show_queue"`
+	ShowMissing                 string        `json:"show_missing"`
+	FeedItems                   string        `json:"feed_items"`
+	EmailAttachICS              string        `json:"email_attach_ics"`
+	EmailInlinePosters          string        `json:"email_inline_posters"`
+	ShowTraktWatchlist          string        `json:"show_trakt_watchlist"`
+	ShowTraktRecommendations    string        `json:"show_trakt_recommendations"`
+	ShowTraktCalendar           string        `json:"show_trakt_calendar"`
 	// Customizable email strings (weekly)
 	EmailTitle                string `json:"email_title"`
 	EmailIntro                string `json:"email_intro"`
@@ -356,6 +715,17 @@ type WebConfig struct {
 	WatchedSeriesHeading      string `json:"watched_series_heading"`
 	AnticipatedMoviesHeading  string `json:"anticipated_movies_heading"`
 	WatchedMoviesHeading      string `json:"watched_movies_heading"`
+	MostWatchedHeading        string `json:"most_watched_heading"`
+	RecentlyAddedHeading      string `json:"recently_added_heading"`
+	MusicHeading              string `json:"music_heading"`
+	BooksHeading              string `json:"books_heading"`
+	PendingRequestsHeading    string `json:"pending_requests_heading"`
+	ServerStatsHeading        string `json:"server_stats_heading"`
+	QueueHeading              string `json:"queue_heading"`
+	MissingHeading            string `json:"missing_heading"`
+	TraktWatchlistHeading     string `json:"trakt_watchlist_heading"`
+	RecommendationsHeading    string `json:"recommendations_heading"`
+	TraktCalendarHeading      string `json:"trakt_calendar_heading"`
 	FooterText                string `json:"footer_text"`
 	// Customizable email strings (monthly)
 	MonthlyEmailTitle                string `json:"monthly_email_title"`
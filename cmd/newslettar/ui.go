@@ -201,6 +201,13 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
             display: none;
         }
         .error-message.show { display: block; }
+        .arr-instance {
+            margin: 20px 0;
+            padding: 15px;
+            background: #0f1419;
+            border-left: 3px solid #667eea;
+            border-radius: 8px;
+        }
         .btn {
             padding: 12px 24px;
             background: linear-gradient(135deg, #667eea 0%, #764ba2 100%);
@@ -707,14 +714,71 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                             <span class="stat-label">Radarr:</span>
                             <span class="stat-value"><span id="status-radarr" class="status-indicator">⚫</span> <span id="status-radarr-text">Checking...</span></span>
                         </div>
+                        <div class="stat-row">
+                            <span class="stat-label">Readarr:</span>
+                            <span class="stat-value"><span id="status-readarr" class="status-indicator">⚫</span> <span id="status-readarr-text">Checking...</span></span>
+                        </div>
+                        <div id="status-instances"></div>
+                        <div class="stat-row">
+                            <span class="stat-label">Lidarr:</span>
+                            <span class="stat-value"><span id="status-lidarr" class="status-indicator">⚫</span> <span id="status-lidarr-text">Checking...</span></span>
+                        </div>
                         <div class="stat-row">
                             <span class="stat-label">Email:</span>
                             <span class="stat-value"><span id="status-email" class="status-indicator">⚫</span> <span id="status-email-text">Checking...</span></span>
                         </div>
+                        <div class="stat-row">
+                            <span class="stat-label">Slack:</span>
+                            <span class="stat-value"><span id="status-slack" class="status-indicator">⚫</span> <span id="status-slack-text">Checking...</span></span>
+                        </div>
+                        <div class="stat-row">
+                            <span class="stat-label">Discord:</span>
+                            <span class="stat-value"><span id="status-discord" class="status-indicator">⚫</span> <span id="status-discord-text">Checking...</span></span>
+                        </div>
+                        <div class="stat-row">
+                            <span class="stat-label">Telegram:</span>
+                            <span class="stat-value"><span id="status-telegram" class="status-indicator">⚫</span> <span id="status-telegram-text">Checking...</span></span>
+                        </div>
+                        <div class="stat-row">
+                            <span class="stat-label">Matrix:</span>
+                            <span class="stat-value"><span id="status-matrix" class="status-indicator">⚫</span> <span id="status-matrix-text">Checking...</span></span>
+                        </div>
+                        <div class="stat-row">
+                            <span class="stat-label">ntfy:</span>
+                            <span class="stat-value"><span id="status-ntfy" class="status-indicator">⚫</span> <span id="status-ntfy-text">Checking...</span></span>
+                        </div>
+                        <div class="stat-row">
+                            <span class="stat-label">Gotify:</span>
+                            <span class="stat-value"><span id="status-gotify" class="status-indicator">⚫</span> <span id="status-gotify-text">Checking...</span></span>
+                        </div>
+                        <div class="stat-row">
+                            <span class="stat-label">Webhook:</span>
+                            <span class="stat-value"><span id="status-webhook" class="status-indicator">⚫</span> <span id="status-webhook-text">Checking...</span></span>
+                        </div>
                         <div class="stat-row">
                             <span class="stat-label">Trakt:</span>
                             <span class="stat-value"><span id="status-trakt" class="status-indicator">⚫</span> <span id="status-trakt-text">Checking...</span></span>
                         </div>
+                        <div class="stat-row">
+                            <span class="stat-label">Jellyfin/Emby:</span>
+                            <span class="stat-value"><span id="status-jellyfin" class="status-indicator">⚫</span> <span id="status-jellyfin-text">Checking...</span></span>
+                        </div>
+                        <div class="stat-row">
+                            <span class="stat-label">Plex:</span>
+                            <span class="stat-value"><span id="status-plex" class="status-indicator">⚫</span> <span id="status-plex-text">Checking...</span></span>
+                        </div>
+                        <div class="stat-row">
+                            <span class="stat-label">Tautulli:</span>
+                            <span class="stat-value"><span id="status-tautulli" class="status-indicator">⚫</span> <span id="status-tautulli-text">Checking...</span></span>
+                        </div>
+                        <div class="stat-row">
+                            <span class="stat-label">Bazarr:</span>
+                            <span class="stat-value"><span id="status-bazarr" class="status-indicator">⚫</span> <span id="status-bazarr-text">Checking...</span></span>
+                        </div>
+                        <div class="stat-row">
+                            <span class="stat-label">Overseerr:</span>
+                            <span class="stat-value"><span id="status-overseerr" class="status-indicator">⚫</span> <span id="status-overseerr-text">Checking...</span></span>
+                        </div>
                     </div>
                 </div>
 
@@ -805,6 +869,10 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                 <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
 
                 <h3 style="margin-bottom: 15px; color: #667eea;">Sonarr Settings</h3>
+                <div class="form-group">
+                    <label for="sonarr_name">Instance Name</label>
+                    <input type="text" name="sonarr_name" id="sonarr_name" placeholder="Sonarr" aria-label="Sonarr instance name">
+                </div>
                 <div class="form-group">
                     <label for="sonarr_url">Sonarr URL</label>
                     <input type="url" name="sonarr_url" id="sonarr_url" placeholder="http://localhost:8989" aria-label="Sonarr URL">
@@ -817,10 +885,18 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                 <button type="button" class="btn btn-secondary" onclick="testConnection('sonarr')" aria-label="Test Sonarr connection">
                     <span>Test Sonarr</span>
                 </button>
+                <div id="sonarr-instances"></div>
+                <button type="button" class="btn btn-secondary" onclick="addArrInstance('sonarr')" aria-label="Add another Sonarr instance">
+                    <span><i data-lucide="plus"></i> Add Sonarr Instance</span>
+                </button>
 
                 <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
 
                 <h3 style="margin-bottom: 15px; color: #667eea;">Radarr Settings</h3>
+                <div class="form-group">
+                    <label for="radarr_name">Instance Name</label>
+                    <input type="text" name="radarr_name" id="radarr_name" placeholder="Radarr" aria-label="Radarr instance name">
+                </div>
                 <div class="form-group">
                     <label for="radarr_url">Radarr URL</label>
                     <input type="url" name="radarr_url" id="radarr_url" placeholder="http://localhost:7878" aria-label="Radarr URL">
@@ -833,6 +909,58 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                 <button type="button" class="btn btn-secondary" onclick="testConnection('radarr')" aria-label="Test Radarr connection">
                     <span>Test Radarr</span>
                 </button>
+                <div id="radarr-instances"></div>
+                <button type="button" class="btn btn-secondary" onclick="addArrInstance('radarr')" aria-label="Add another Radarr instance">
+                    <span><i data-lucide="plus"></i> Add Radarr Instance</span>
+                </button>
+
+                <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+                <h3 style="margin-bottom: 15px; color: #667eea;">Readarr Settings (Optional)</h3>
+                <div class="info-banner" style="margin-bottom: 20px;">
+                    <p style="font-size: 0.9em;">
+                        <i data-lucide="info"></i> Adds a "Books" section with imported and upcoming books. Add a second instance if you keep audiobooks in a separate Readarr.
+                    </p>
+                </div>
+                <div class="form-group">
+                    <label for="readarr_name">Instance Name</label>
+                    <input type="text" name="readarr_name" id="readarr_name" placeholder="Readarr" aria-label="Readarr instance name">
+                </div>
+                <div class="form-group">
+                    <label for="readarr_url">Readarr URL</label>
+                    <input type="url" name="readarr_url" id="readarr_url" placeholder="http://localhost:8787" aria-label="Readarr URL">
+                </div>
+                <div class="form-group">
+                    <label for="readarr_api_key">Readarr API Key</label>
+                    <input type="text" name="readarr_api_key" id="readarr_api_key" placeholder="Your Readarr API key" aria-label="Readarr API Key">
+                </div>
+                <button type="button" class="btn btn-secondary" onclick="testConnection('readarr')" aria-label="Test Readarr connection">
+                    <span>Test Readarr</span>
+                </button>
+                <div id="readarr-instances"></div>
+                <button type="button" class="btn btn-secondary" onclick="addArrInstance('readarr')" aria-label="Add another Readarr instance">
+                    <span><i data-lucide="plus"></i> Add Readarr Instance</span>
+                </button>
+
+                <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+                <h3 style="margin-bottom: 15px; color: #667eea;">Lidarr Settings (Optional)</h3>
+                <div class="info-banner" style="margin-bottom: 20px;">
+                    <p style="font-size: 0.9em;">
+                        <i data-lucide="info"></i> Adds a "Music" section with imported albums and upcoming album releases.
+                    </p>
+                </div>
+                <div class="form-group">
+                    <label for="lidarr_url">Lidarr URL</label>
+                    <input type="url" name="lidarr_url" id="lidarr_url" placeholder="http://localhost:8686" aria-label="Lidarr URL">
+                </div>
+                <div class="form-group">
+                    <label for="lidarr_api_key">Lidarr API Key</label>
+                    <input type="text" name="lidarr_api_key" id="lidarr_api_key" placeholder="Your Lidarr API key" aria-label="Lidarr API Key">
+                </div>
+                <button type="button" class="btn btn-secondary" onclick="testConnection('lidarr')" aria-label="Test Lidarr connection">
+                    <span>Test Lidarr</span>
+                </button>
 
                 <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
 
@@ -841,16 +969,163 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                     <p style="font-size: 0.9em;">
                         <i data-lucide="info"></i> Trakt integration enables trending content sections in your newsletter.
                         <a href="https://trakt.tv/oauth/applications" target="_blank" style="color: #667eea; text-decoration: underline;">Create an app</a>
-                        and copy your <strong>Client ID</strong> (Client Secret is not needed).
+                        and copy your <strong>Client ID</strong>. The <strong>Client Secret</strong> is only needed to connect your own Trakt account
+                        for the watchlist, recommendations and calendar sections (use <code>urn:ietf:wg:oauth:2.0:oob</code> as redirect URI).
                     </p>
                 </div>
                 <div class="form-group">
                     <label for="trakt_client_id">Trakt Client ID</label>
                     <input type="text" name="trakt_client_id" id="trakt_client_id" placeholder="Your Trakt Client ID" aria-label="Trakt Client ID">
                 </div>
+                <div class="form-group">
+                    <label for="trakt_client_secret">Trakt Client Secret</label>
+                    <input type="text" name="trakt_client_secret" id="trakt_client_secret" placeholder="Your Trakt Client Secret (optional)" aria-label="Trakt Client Secret">
+                </div>
                 <button type="button" class="btn btn-secondary" onclick="testConnection('trakt')" aria-label="Test Trakt connection">
                     <span>Test Trakt</span>
                 </button>
+                <button type="button" class="btn btn-secondary" id="trakt-connect-btn" onclick="connectTrakt()" aria-label="Connect Trakt account">
+                    <span>Connect Trakt Account</span>
+                </button>
+                <button type="button" class="btn btn-secondary" id="trakt-disconnect-btn" onclick="disconnectTrakt()" style="display: none;" aria-label="Disconnect Trakt account">
+                    <span>Disconnect</span>
+                </button>
+                <p id="trakt-account-status" style="font-size: 0.9em; color: #8899aa; margin-top: 10px;">No Trakt account connected</p>
+                <div id="trakt-device-code" class="info-banner" style="display: none; margin-top: 10px;">
+                    <p style="font-size: 0.9em;">
+                        Go to <a id="trakt-device-url" href="https://trakt.tv/activate" target="_blank" style="color: #667eea; text-decoration: underline;">trakt.tv/activate</a>
+                        and enter the code <strong id="trakt-user-code" style="font-size: 1.2em; letter-spacing: 2px;"></strong>. Waiting for approval...
+                    </p>
+                </div>
+                <div class="form-group" style="margin-top: 20px;">
+                    <label for="tmdb_api_key">TMDB API Key (optional)</label>
+                    <input type="text" name="tmdb_api_key" id="tmdb_api_key" placeholder="TMDB API key or read access token" aria-label="TMDB API Key">
+                    <small style="color: #8899aa; font-size: 0.85em; display: block; margin-top: 5px;">Adds posters to the Trakt sections. Get a free key at <a href="https://www.themoviedb.org/settings/api" target="_blank" style="color: #667eea;">themoviedb.org</a>.</small>
+                </div>
+                <button type="button" class="btn btn-secondary" onclick="testConnection('tmdb')" aria-label="Test TMDB connection">
+                    <span>Test TMDB</span>
+                </button>
+
+                <h4 style="margin: 25px 0 10px 0;">Custom Trakt Lists</h4>
+                <p style="font-size: 0.9em; color: #8899aa; margin-bottom: 10px;">
+                    Each list becomes its own newsletter section. Paste a list URL such as <code>https://trakt.tv/users/&lt;user&gt;/lists/&lt;list&gt;</code> or an official <code>https://trakt.tv/lists/&lt;id&gt;</code> list.
+                </p>
+                <div id="trakt-lists"></div>
+                <button type="button" class="btn btn-secondary" onclick="addTraktList()" aria-label="Add a Trakt list section">
+                    <span><i data-lucide="plus"></i> Add Trakt List</span>
+                </button>
+
+                <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+                <h3 style="margin-bottom: 15px; color: #667eea;">Jellyfin / Emby Settings (Optional)</h3>
+                <div class="info-banner" style="margin-bottom: 20px;">
+                    <p style="font-size: 0.9em;">
+                        <i data-lucide="info"></i> Adds "Most watched on our server" and "Recently added" sections based on what is actually on your media server.
+                        Create an API key under <strong>Dashboard → API Keys</strong>. Play counts require the <strong>Playback Reporting</strong> plugin.
+                    </p>
+                </div>
+                <div class="form-group">
+                    <label for="jellyfin_server_type">Server Type</label>
+                    <select name="jellyfin_server_type" id="jellyfin_server_type" aria-label="Media server type">
+                        <option value="jellyfin">Jellyfin</option>
+                        <option value="emby">Emby</option>
+                    </select>
+                </div>
+                <div class="form-group">
+                    <label for="jellyfin_url">Server URL</label>
+                    <input type="url" name="jellyfin_url" id="jellyfin_url" placeholder="http://localhost:8096" aria-label="Jellyfin or Emby URL">
+                </div>
+                <div class="form-group">
+                    <label for="jellyfin_api_key">API Key</label>
+                    <input type="text" name="jellyfin_api_key" id="jellyfin_api_key" placeholder="Your Jellyfin/Emby API key" aria-label="Jellyfin or Emby API key">
+                </div>
+                <button type="button" class="btn btn-secondary" onclick="testConnection('jellyfin')" aria-label="Test Jellyfin or Emby connection">
+                    <span>Test Media Server</span>
+                </button>
+
+                <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+                <h3 style="margin-bottom: 15px; color: #667eea;">Plex Settings (Optional)</h3>
+                <div class="info-banner" style="margin-bottom: 20px;">
+                    <p style="font-size: 0.9em;">
+                        <i data-lucide="info"></i> Adds Plex items to "Recently added", marks Trakt titles you already have on Plex as in your library, and links every title to Plex.
+                        Find your token by opening any item's <strong>Get Info → View XML</strong> and copying the <strong>X-Plex-Token</strong> value from the URL.
+                    </p>
+                </div>
+                <div class="form-group">
+                    <label for="plex_url">Server URL</label>
+                    <input type="url" name="plex_url" id="plex_url" placeholder="http://localhost:32400" aria-label="Plex URL">
+                </div>
+                <div class="form-group">
+                    <label for="plex_token">Token</label>
+                    <input type="text" name="plex_token" id="plex_token" placeholder="Your X-Plex-Token" aria-label="Plex token">
+                </div>
+                <button type="button" class="btn btn-secondary" onclick="testConnection('plex')" aria-label="Test Plex connection">
+                    <span>Test Plex</span>
+                </button>
+
+                <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+                <h3 style="margin-bottom: 15px; color: #667eea;">Tautulli Settings (Optional)</h3>
+                <div class="info-banner" style="margin-bottom: 20px;">
+                    <p style="font-size: 0.9em;">
+                        <i data-lucide="info"></i> Adds a "Server stats" block with the most played movies and shows, the most active platforms and the total watch time of the period.
+                        Find your API key under <strong>Settings → Web Interface → API</strong>.
+                    </p>
+                </div>
+                <div class="form-group">
+                    <label for="tautulli_url">Server URL</label>
+                    <input type="url" name="tautulli_url" id="tautulli_url" placeholder="http://localhost:8181" aria-label="Tautulli URL">
+                </div>
+                <div class="form-group">
+                    <label for="tautulli_api_key">API Key</label>
+                    <input type="text" name="tautulli_api_key" id="tautulli_api_key" placeholder="Your Tautulli API key" aria-label="Tautulli API key">
+                </div>
+                <button type="button" class="btn btn-secondary" onclick="testConnection('tautulli')" aria-label="Test Tautulli connection">
+                    <span>Test Tautulli</span>
+                </button>
+
+                <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+                <h3 style="margin-bottom: 15px; color: #667eea;">Bazarr Settings (Optional)</h3>
+                <div class="info-banner" style="margin-bottom: 20px;">
+                    <p style="font-size: 0.9em;">
+                        <i data-lucide="info"></i> Annotates downloaded episodes and movies with their subtitle languages, or a "missing subtitles" badge. Bazarr must be connected to the same Sonarr and Radarr.
+                        Find your API key under <strong>Settings → General → Security</strong>.
+                    </p>
+                </div>
+                <div class="form-group">
+                    <label for="bazarr_url">Server URL</label>
+                    <input type="url" name="bazarr_url" id="bazarr_url" placeholder="http://localhost:6767" aria-label="Bazarr URL">
+                </div>
+                <div class="form-group">
+                    <label for="bazarr_api_key">API Key</label>
+                    <input type="text" name="bazarr_api_key" id="bazarr_api_key" placeholder="Your Bazarr API key" aria-label="Bazarr API key">
+                </div>
+                <button type="button" class="btn btn-secondary" onclick="testConnection('bazarr')" aria-label="Test Bazarr connection">
+                    <span>Test Bazarr</span>
+                </button>
+
+                <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+                <h3 style="margin-bottom: 15px; color: #667eea;">Overseerr / Jellyseerr Settings (Optional)</h3>
+                <div class="info-banner" style="margin-bottom: 20px;">
+                    <p style="font-size: 0.9em;">
+                        <i data-lucide="info"></i> Shows who requested each downloaded show and movie, and lists approved requests that are not available yet.
+                        Find your API key under <strong>Settings → General</strong>.
+                    </p>
+                </div>
+                <div class="form-group">
+                    <label for="overseerr_url">Server URL</label>
+                    <input type="url" name="overseerr_url" id="overseerr_url" placeholder="http://localhost:5055" aria-label="Overseerr URL">
+                </div>
+                <div class="form-group">
+                    <label for="overseerr_api_key">API Key</label>
+                    <input type="text" name="overseerr_api_key" id="overseerr_api_key" placeholder="Your Overseerr/Jellyseerr API key" aria-label="Overseerr API key">
+                </div>
+                <button type="button" class="btn btn-secondary" onclick="testConnection('overseerr')" aria-label="Test Overseerr connection">
+                    <span>Test Overseerr</span>
+                </button>
 
                 <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
 
@@ -885,6 +1160,26 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                     <label for="smtp_pass">SMTP Password</label>
                     <input type="password" name="smtp_pass" id="smtp_pass" placeholder="Your SMTP password" aria-label="SMTP Password">
                 </div>
+                <div class="form-group">
+                    <label for="smtp_security">Connection Security</label>
+                    <select name="smtp_security" id="smtp_security" aria-label="SMTP connection security">
+                        <option value="starttls-opportunistic">STARTTLS when offered</option>
+                        <option value="starttls-required">STARTTLS required</option>
+                        <option value="implicit-tls">Implicit TLS (SMTPS)</option>
+                        <option value="none">None (unencrypted, no authentication)</option>
+                    </select>
+                </div>
+                <div class="form-group">
+                    <label for="smtp_ca_file">Custom CA Certificate (optional)</label>
+                    <input type="text" name="smtp_ca_file" id="smtp_ca_file" placeholder="/etc/ssl/my-relay-ca.pem" aria-label="SMTP CA certificate file">
+                </div>
+                <div class="form-group">
+                    <label for="smtp_tls_skip_verify">Certificate Verification</label>
+                    <select name="smtp_tls_skip_verify" id="smtp_tls_skip_verify" aria-label="SMTP certificate verification">
+                        <option value="false">Verify server certificate</option>
+                        <option value="true">Skip verification (insecure)</option>
+                    </select>
+                </div>
                 <div class="form-group">
                     <label for="from_name">From Name</label>
                     <input type="text" name="from_name" id="from_name" placeholder="Newslettar" aria-label="From Name">
@@ -900,6 +1195,216 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
 
                 <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
 
+                <h3 style="margin-bottom: 15px; color: #667eea;">Slack / Mattermost Settings (Optional)</h3>
+                <div class="info-banner" style="margin-bottom: 20px;">
+                    <p style="font-size: 0.9em;">
+                        <i data-lucide="info"></i> Also posts the newsletter to an incoming webhook, with a section and poster per series and movie. Works with or without email.
+                        In Slack, add the <strong>Incoming Webhooks</strong> app to a channel; in Mattermost, use <strong>Integrations → Incoming Webhooks</strong>.
+                    </p>
+                </div>
+                <div class="form-group">
+                    <label for="slack_webhook_url">Webhook URL</label>
+                    <input type="text" name="slack_webhook_url" id="slack_webhook_url" placeholder="https://hooks.slack.com/services/..." aria-label="Slack webhook URL">
+                </div>
+                <button type="button" class="btn btn-secondary" onclick="testConnection('slack')" aria-label="Test Slack webhook">
+                    <span>Test Slack</span>
+                </button>
+
+                <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+                <h3 style="margin-bottom: 15px; color: #667eea;">Discord Settings (Optional)</h3>
+                <div class="info-banner" style="margin-bottom: 20px;">
+                    <p style="font-size: 0.9em;">
+                        <i data-lucide="info"></i> Also posts the newsletter to a Discord channel, with one embed per series and movie. Works with or without email.
+                        Create a webhook under <strong>Channel Settings → Integrations → Webhooks</strong>.
+                    </p>
+                </div>
+                <div class="form-group">
+                    <label for="discord_webhook_url">Webhook URL</label>
+                    <input type="text" name="discord_webhook_url" id="discord_webhook_url" placeholder="https://discord.com/api/webhooks/..." aria-label="Discord webhook URL">
+                </div>
+                <button type="button" class="btn btn-secondary" onclick="testConnection('discord')" aria-label="Test Discord webhook">
+                    <span>Test Discord</span>
+                </button>
+
+                <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+                <h3 style="margin-bottom: 15px; color: #667eea;">Telegram Settings (Optional)</h3>
+                <div class="info-banner" style="margin-bottom: 20px;">
+                    <p style="font-size: 0.9em;">
+                        <i data-lucide="info"></i> Also posts the newsletter to Telegram chats: a summary message followed by the posters with their episodes and release dates.
+                        Create a bot with <strong>@BotFather</strong>, add it to your chats and enter the chat IDs (or <strong>@channelname</strong> for public channels).
+                    </p>
+                </div>
+                <div class="form-group">
+                    <label for="telegram_bot_token">Bot Token</label>
+                    <input type="text" name="telegram_bot_token" id="telegram_bot_token" placeholder="123456789:ABC..." aria-label="Telegram bot token">
+                </div>
+                <div class="form-group">
+                    <label for="telegram_chat_ids">Chat IDs (comma-separated)</label>
+                    <input type="text" name="telegram_chat_ids" id="telegram_chat_ids" placeholder="-1001234567890, @mychannel" aria-label="Telegram chat IDs">
+                </div>
+                <div class="form-group">
+                    <label for="telegram_api_url">Bot API URL</label>
+                    <input type="url" name="telegram_api_url" id="telegram_api_url" placeholder="https://api.telegram.org" aria-label="Telegram Bot API URL">
+                </div>
+                <button type="button" class="btn btn-secondary" onclick="testConnection('telegram')" aria-label="Test Telegram bot">
+                    <span>Test Telegram</span>
+                </button>
+
+                <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+                <h3 style="margin-bottom: 15px; color: #667eea;">Newsletter Archive</h3>
+                <div class="info-banner" style="margin-bottom: 20px;">
+                    <p style="font-size: 0.9em;">
+                        <i data-lucide="info"></i> Every sent newsletter is archived and available at <strong>/archive/</strong> on this web UI.
+                        Set the address recipients can reach it under so the Matrix, ntfy and Gotify notifications can link to the full newsletter.
+                    </p>
+                </div>
+                <div class="form-group">
+                    <label for="public_url">Public URL</label>
+                    <input type="url" name="public_url" id="public_url" placeholder="https://newslettar.example.com" aria-label="Public URL">
+                </div>
+
+                <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+                <h3 style="margin-bottom: 15px; color: #667eea;">Matrix Settings (Optional)</h3>
+                <div class="info-banner" style="margin-bottom: 20px;">
+                    <p style="font-size: 0.9em;">
+                        <i data-lucide="info"></i> Posts a short summary ("5 episodes, 2 movies arriving this week") with a link to the archived newsletter to a Matrix room.
+                        Use the access token of a (bot) account that has joined the room; in Element it is under <strong>Settings → Help &amp; About → Access Token</strong>.
+                    </p>
+                </div>
+                <div class="form-group">
+                    <label for="matrix_homeserver_url">Homeserver URL</label>
+                    <input type="url" name="matrix_homeserver_url" id="matrix_homeserver_url" placeholder="https://matrix.example.com" aria-label="Matrix homeserver URL">
+                </div>
+                <div class="form-group">
+                    <label for="matrix_access_token">Access Token</label>
+                    <input type="text" name="matrix_access_token" id="matrix_access_token" placeholder="syt_..." aria-label="Matrix access token">
+                </div>
+                <div class="form-group">
+                    <label for="matrix_room_id">Room ID</label>
+                    <input type="text" name="matrix_room_id" id="matrix_room_id" placeholder="!abcdefg:example.com" aria-label="Matrix room ID">
+                </div>
+                <button type="button" class="btn btn-secondary" onclick="testConnection('matrix')" aria-label="Test Matrix connection">
+                    <span>Test Matrix</span>
+                </button>
+
+                <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+                <h3 style="margin-bottom: 15px; color: #667eea;">ntfy Settings (Optional)</h3>
+                <div class="info-banner" style="margin-bottom: 20px;">
+                    <p style="font-size: 0.9em;">
+                        <i data-lucide="info"></i> Publishes a short summary to an ntfy topic; tapping the notification opens the archived newsletter.
+                        The access token is only needed for protected topics.
+                    </p>
+                </div>
+                <div class="form-group">
+                    <label for="ntfy_url">Server URL</label>
+                    <input type="url" name="ntfy_url" id="ntfy_url" placeholder="https://ntfy.sh" aria-label="ntfy server URL">
+                </div>
+                <div class="form-group">
+                    <label for="ntfy_topic">Topic</label>
+                    <input type="text" name="ntfy_topic" id="ntfy_topic" placeholder="my-newsletter" aria-label="ntfy topic">
+                </div>
+                <div class="form-group">
+                    <label for="ntfy_token">Access Token (optional)</label>
+                    <input type="text" name="ntfy_token" id="ntfy_token" placeholder="tk_..." aria-label="ntfy access token">
+                </div>
+                <button type="button" class="btn btn-secondary" onclick="testConnection('ntfy')" aria-label="Test ntfy connection">
+                    <span>Test ntfy</span>
+                </button>
+
+                <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+                <h3 style="margin-bottom: 15px; color: #667eea;">Gotify Settings (Optional)</h3>
+                <div class="info-banner" style="margin-bottom: 20px;">
+                    <p style="font-size: 0.9em;">
+                        <i data-lucide="info"></i> Sends a short summary with a link to the archived newsletter as a Gotify message.
+                        Create an application under <strong>Apps → Create Application</strong> and use its token.
+                    </p>
+                </div>
+                <div class="form-group">
+                    <label for="gotify_url">Server URL</label>
+                    <input type="url" name="gotify_url" id="gotify_url" placeholder="https://gotify.example.com" aria-label="Gotify URL">
+                </div>
+                <div class="form-group">
+                    <label for="gotify_token">Application Token</label>
+                    <input type="text" name="gotify_token" id="gotify_token" placeholder="Your Gotify application token" aria-label="Gotify application token">
+                </div>
+                <button type="button" class="btn btn-secondary" onclick="testConnection('gotify')" aria-label="Test Gotify connection">
+                    <span>Test Gotify</span>
+                </button>
+
+                <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+                <h3 style="margin-bottom: 15px; color: #667eea;">Webhook Settings (Optional)</h3>
+                <div class="info-banner" style="margin-bottom: 20px;">
+                    <p style="font-size: 0.9em;">
+                        <i data-lucide="info"></i> POSTs the full newsletter data (series, movies, Trakt sections and period) as JSON after each run, e.g. to Home Assistant or n8n.
+                        With a secret, every request carries an <strong>X-Newslettar-Signature: sha256=&lt;hex&gt;</strong> HMAC-SHA256 of the body.
+                    </p>
+                </div>
+                <div class="form-group">
+                    <label for="webhook_url">Webhook URL</label>
+                    <input type="url" name="webhook_url" id="webhook_url" placeholder="https://homeassistant.local:8123/api/webhook/newslettar" aria-label="Webhook URL">
+                </div>
+                <div class="form-group">
+                    <label for="webhook_secret">Signing Secret</label>
+                    <input type="text" name="webhook_secret" id="webhook_secret" placeholder="Shared secret for the HMAC signature" aria-label="Webhook signing secret">
+                </div>
+                <button type="button" class="btn btn-secondary" onclick="testConnection('webhook')" aria-label="Test webhook">
+                    <span>Test Webhook</span>
+                </button>
+
+                <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+                <h3 style="margin-bottom: 15px; color: #667eea;">Admin Digest (Optional)</h3>
+                <div class="info-banner" style="margin-bottom: 20px;">
+                    <p style="font-size: 0.9em;">
+                        <i data-lucide="info"></i> Sends a separate health report to the admins: Sonarr/Radarr/Readarr/Lidarr health check warnings (e.g. indexers down), disks that are nearly full and unreachable instances.
+                        Leave the recipients empty to disable it. Uses the SMTP settings above.
+                    </p>
+                </div>
+                <div class="form-group">
+                    <label for="admin_emails">Admin Email Addresses (comma-separated)</label>
+                    <input type="text" name="admin_emails" id="admin_emails" placeholder="admin@yourdomain.com" aria-label="Admin email addresses">
+                </div>
+                <div class="form-group">
+                    <label for="admin_schedule_type">Frequency</label>
+                    <select name="admin_schedule_type" id="admin_schedule_type" aria-label="Select admin digest frequency">
+                        <option value="daily">Daily</option>
+                        <option value="weekly">Weekly</option>
+                    </select>
+                </div>
+                <div class="form-group" id="admin-day-group" style="display: none;">
+                    <label for="admin_schedule_day">Day of Week</label>
+                    <select name="admin_schedule_day" id="admin_schedule_day" aria-label="Select admin digest day">
+                        <option value="Sun">Sunday</option>
+                        <option value="Mon">Monday</option>
+                        <option value="Tue">Tuesday</option>
+                        <option value="Wed">Wednesday</option>
+                        <option value="Thu">Thursday</option>
+                        <option value="Fri">Friday</option>
+                        <option value="Sat">Saturday</option>
+                    </select>
+                </div>
+                <div class="form-group">
+                    <label for="admin_schedule_time">Time (24-hour format, HH:MM)</label>
+                    <input type="time" name="admin_schedule_time" id="admin_schedule_time" aria-label="Select admin digest time">
+                </div>
+                <div class="action-buttons">
+                    <button type="button" class="btn btn-secondary" onclick="previewAdminDigest()" aria-label="Preview admin digest">
+                        <span><i data-lucide="eye"></i> Preview Admin Digest</span>
+                    </button>
+                    <button type="button" class="btn btn-secondary" onclick="sendAdminDigest()" aria-label="Send admin digest now">
+                        <span><i data-lucide="send"></i> Send Admin Digest Now</span>
+                    </button>
+                </div>
+
+                <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
                 <button type="submit" class="btn" aria-label="Save configuration">
                     <span>💾 Save Configuration</span>
                 </button>
@@ -917,36 +1422,166 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                 </p>
             </div>
 
-            <button class="btn" onclick="openEditStringsModal()" aria-label="Edit email strings">
-                <span><i data-lucide="edit"></i> Edit Email Strings</span>
-            </button>
+            <button class="btn" onclick="openEditStringsModal()" aria-label="Edit email strings">
+                <span><i data-lucide="edit"></i> Edit Email Strings</span>
+            </button>
+
+            <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+            <h3 style="margin-bottom: 15px;">Display Options</h3>
+
+            <div class="template-option">
+                <div>
+                    <strong>Show Movie/Series Posters</strong>
+                    <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
+                        Display poster images in the newsletter
+                    </p>
+                </div>
+                <label class="toggle-switch">
+                    <input type="checkbox" id="show-posters" onchange="saveTemplateSettings()" aria-label="Toggle poster display">
+                    <span class="toggle-slider"></span>
+                </label>
+            </div>
+
+            <div class="template-option">
+                <div>
+                    <strong>Show Downloaded Section</strong>
+                    <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
+                        Include "Downloaded Last Week" section
+                    </p>
+                </div>
+                <label class="toggle-switch">
+                    <input type="checkbox" id="show-downloaded" onchange="saveTemplateSettings()" aria-label="Toggle downloaded section">
+                    <span class="toggle-slider"></span>
+                </label>
+            </div>
+
+            <div class="template-option">
+                <div>
+                    <strong>Show Music Section</strong>
+                    <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
+                        Include imported and upcoming albums from Lidarr
+                    </p>
+                </div>
+                <label class="toggle-switch">
+                    <input type="checkbox" id="show-music" onchange="saveTemplateSettings()" aria-label="Toggle music section">
+                    <span class="toggle-slider"></span>
+                </label>
+            </div>
+
+            <div class="template-option">
+                <div>
+                    <strong>Show Books Section</strong>
+                    <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
+                        Include imported and upcoming books and audiobooks from Readarr
+                    </p>
+                </div>
+                <label class="toggle-switch">
+                    <input type="checkbox" id="show-books" onchange="saveTemplateSettings()" aria-label="Toggle books section">
+                    <span class="toggle-slider"></span>
+                </label>
+            </div>
+
+            <div class="template-option">
+                <div>
+                    <strong>Show Downloading Now</strong>
+                    <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
+                        Include episodes and movies currently in the Sonarr/Radarr download queue, with progress and time left
+                    </p>
+                </div>
+                <label class="toggle-switch">
+                    <input type="checkbox" id="show-queue" onchange="saveTemplateSettings()" aria-label="Toggle downloading now section">
+                    <span class="toggle-slider"></span>
+                </label>
+            </div>
+
+            <div class="template-option">
+                <div>
+                    <strong>Show Missing Episodes &amp; Movies</strong>
+                    <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
+                        List monitored episodes and movies that have aired or been released but have no file yet
+                    </p>
+                </div>
+                <label class="toggle-switch">
+                    <input type="checkbox" id="show-missing" onchange="saveTemplateSettings()" aria-label="Toggle missing section">
+                    <span class="toggle-slider"></span>
+                </label>
+            </div>
+
+            <div class="template-option">
+                <div>
+                    <strong>Downloaded Items in Feeds</strong>
+                    <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
+                        Besides one entry per newsletter, publish every downloaded series and movie (with its poster) in the /feed.xml and /rss.xml feeds
+                    </p>
+                </div>
+                <label class="toggle-switch">
+                    <input type="checkbox" id="feed-items" onchange="saveTemplateSettings()" aria-label="Toggle downloaded items in feeds">
+                    <span class="toggle-slider"></span>
+                </label>
+            </div>
+
+            <div class="template-option">
+                <div>
+                    <strong>Attach Calendar (.ics)</strong>
+                    <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
+                        Attach the upcoming episodes and movies to the email as an .ics file recipients can import. A live calendar is always available at /calendar.ics
+                    </p>
+                </div>
+                <label class="toggle-switch">
+                    <input type="checkbox" id="email-attach-ics" onchange="saveTemplateSettings()" aria-label="Toggle calendar attachment">
+                    <span class="toggle-slider"></span>
+                </label>
+            </div>
 
-            <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+            <div class="template-option">
+                <div>
+                    <strong>Embed Posters in Email</strong>
+                    <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
+                        Download the posters while sending and attach them to the email, so they show even when the mail client blocks remote images or Sonarr/Radarr are only reachable on your network
+                    </p>
+                </div>
+                <label class="toggle-switch">
+                    <input type="checkbox" id="email-inline-posters" onchange="saveTemplateSettings()" aria-label="Toggle embedded posters">
+                    <span class="toggle-slider"></span>
+                </label>
+            </div>
 
-            <h3 style="margin-bottom: 15px;">Display Options</h3>
+            <div class="template-option">
+                <div>
+                    <strong>Show Pending Requests</strong>
+                    <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
+                        List approved Overseerr/Jellyseerr requests that are not available yet
+                    </p>
+                </div>
+                <label class="toggle-switch">
+                    <input type="checkbox" id="show-pending-requests" onchange="saveTemplateSettings()" aria-label="Toggle pending requests section">
+                    <span class="toggle-slider"></span>
+                </label>
+            </div>
 
             <div class="template-option">
                 <div>
-                    <strong>Show Movie/Series Posters</strong>
+                    <strong>Show Server Stats</strong>
                     <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
-                        Display poster images in the newsletter
+                        Include Tautulli viewing statistics (top movies, top shows, platforms and total watch time)
                     </p>
                 </div>
                 <label class="toggle-switch">
-                    <input type="checkbox" id="show-posters" onchange="saveTemplateSettings()" aria-label="Toggle poster display">
+                    <input type="checkbox" id="show-server-stats" onchange="saveTemplateSettings()" aria-label="Toggle server stats section">
                     <span class="toggle-slider"></span>
                 </label>
             </div>
 
             <div class="template-option">
                 <div>
-                    <strong>Show Downloaded Section</strong>
+                    <strong>Show Subtitles</strong>
                     <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
-                        Include "Downloaded Last Week" section
+                        Annotate downloaded episodes and movies with their Bazarr subtitle languages
                     </p>
                 </div>
                 <label class="toggle-switch">
-                    <input type="checkbox" id="show-downloaded" onchange="saveTemplateSettings()" aria-label="Toggle downloaded section">
+                    <input type="checkbox" id="show-subtitles" onchange="saveTemplateSettings()" aria-label="Toggle subtitle annotations">
                     <span class="toggle-slider"></span>
                 </label>
             </div>
@@ -1052,7 +1687,7 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                         <div>
                             <strong>Show Most Watched Series</strong>
                             <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
-                                Display most watched series from the last week
+                                Display most watched series from the last week (or month on a monthly schedule)
                             </p>
                         </div>
                         <label class="toggle-switch">
@@ -1094,7 +1729,7 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                         <div>
                             <strong>Show Most Watched Movies</strong>
                             <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
-                                Display most watched movies from the last week
+                                Display most watched movies from the last week (or month on a monthly schedule)
                             </p>
                         </div>
                         <label class="toggle-switch">
@@ -1109,6 +1744,105 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                 </div>
             </div>
 
+            <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+            <h3 style="margin-bottom: 15px;">Trakt Personal Sections</h3>
+            <div class="info-banner" style="margin-bottom: 20px;">
+                <p style="font-size: 0.9em;">
+                    <i data-lucide="info"></i> Requires a connected Trakt account (Configuration tab → Connect Trakt Account).
+                </p>
+            </div>
+
+            <div class="template-option">
+                <div>
+                    <strong>Show Watchlist: Now Available</strong>
+                    <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
+                        List titles from your Trakt watchlist that were downloaded during the newsletter period
+                    </p>
+                </div>
+                <label class="toggle-switch">
+                    <input type="checkbox" id="show-trakt-watchlist" onchange="saveTemplateSettings()" aria-label="Toggle Trakt watchlist section">
+                    <span class="toggle-slider"></span>
+                </label>
+            </div>
+
+            <div class="template-option">
+                <div>
+                    <strong>Show Recommendations</strong>
+                    <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
+                        Display personalized show and movie recommendations from Trakt
+                    </p>
+                </div>
+                <label class="toggle-switch">
+                    <input type="checkbox" id="show-trakt-recommendations" onchange="saveTemplateSettings()" aria-label="Toggle Trakt recommendations section">
+                    <span class="toggle-slider"></span>
+                </label>
+            </div>
+
+            <div class="template-option">
+                <div>
+                    <strong>Show Trakt Calendar</strong>
+                    <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
+                        List upcoming episodes and movies from your personal Trakt calendar
+                    </p>
+                </div>
+                <label class="toggle-switch">
+                    <input type="checkbox" id="show-trakt-calendar" onchange="saveTemplateSettings()" aria-label="Toggle Trakt calendar section">
+                    <span class="toggle-slider"></span>
+                </label>
+            </div>
+
+            <hr style="margin: 30px 0; border: none; border-top: 2px solid #2a3444;">
+
+            <h3 style="margin-bottom: 15px;">Media Server Sections</h3>
+            <div class="info-banner" style="margin-bottom: 20px;">
+                <p style="font-size: 0.9em;">
+                    <i data-lucide="info"></i> Requires a Jellyfin, Emby or Plex server in Configuration tab. Most watched requires Jellyfin or Emby with the Playback Reporting plugin.
+                </p>
+            </div>
+
+            <div class="template-option">
+                <div style="flex: 1;">
+                    <div style="display: flex; justify-content: space-between; align-items: center;">
+                        <div>
+                            <strong>Show Most Watched on Our Server</strong>
+                            <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
+                                Display the most played movies and series on your server during the newsletter period
+                            </p>
+                        </div>
+                        <label class="toggle-switch">
+                            <input type="checkbox" id="show-server-most-watched" onchange="toggleServerLimit('most-watched')" aria-label="Toggle most watched on our server">
+                            <span class="toggle-slider"></span>
+                        </label>
+                    </div>
+                    <div id="server-most-watched-limit-container" style="display: none; margin-top: 10px; padding: 10px; background: #1a2332; border-radius: 6px; border-left: 3px solid #667eea;">
+                        <label for="server-most-watched-limit" style="font-size: 0.85em; color: #a0b0c0; display: block; margin-bottom: 6px;">Number of results (1-20, default: 10)</label>
+                        <input type="number" id="server-most-watched-limit" min="1" max="20" placeholder="10" style="width: 80px; padding: 6px 10px; background: #0f1419; border: 2px solid #2a3444; border-radius: 6px; color: #e8e8e8; font-size: 14px;" onchange="saveTemplateSettings()">
+                    </div>
+                </div>
+            </div>
+
+            <div class="template-option">
+                <div style="flex: 1;">
+                    <div style="display: flex; justify-content: space-between; align-items: center;">
+                        <div>
+                            <strong>Show Recently Added</strong>
+                            <p style="font-size: 0.9em; color: #8899aa; margin-top: 5px;">
+                                Display movies and series that showed up on your server during the newsletter period
+                            </p>
+                        </div>
+                        <label class="toggle-switch">
+                            <input type="checkbox" id="show-server-recently-added" onchange="toggleServerLimit('recently-added')" aria-label="Toggle recently added">
+                            <span class="toggle-slider"></span>
+                        </label>
+                    </div>
+                    <div id="server-recently-added-limit-container" style="display: none; margin-top: 10px; padding: 10px; background: #1a2332; border-radius: 6px; border-left: 3px solid #667eea;">
+                        <label for="server-recently-added-limit" style="font-size: 0.85em; color: #a0b0c0; display: block; margin-bottom: 6px;">Number of results (1-20, default: 10)</label>
+                        <input type="number" id="server-recently-added-limit" min="1" max="20" placeholder="10" style="width: 80px; padding: 6px 10px; background: #0f1419; border: 2px solid #2a3444; border-radius: 6px; color: #e8e8e8; font-size: 14px;" onchange="saveTemplateSettings()">
+                    </div>
+                </div>
+            </div>
+
             <p style="margin-top: 20px; color: #8899aa; font-size: 0.9em;">
                 <i data-lucide="info"></i> Changes are saved automatically when you toggle switches.
             </p>
@@ -1336,6 +2070,61 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                         <input type="text" id="trending-section-heading" name="trending_section_heading" placeholder="e.g., Trending">
                     </div>
 
+                    <div class="form-group">
+                        <label for="most-watched-heading">Most Watched on Server Heading</label>
+                        <input type="text" id="most-watched-heading" name="most_watched_heading" placeholder="e.g., Most watched on our server">
+                    </div>
+
+                    <div class="form-group">
+                        <label for="recently-added-heading">Recently Added Heading</label>
+                        <input type="text" id="recently-added-heading" name="recently_added_heading" placeholder="e.g., Recently added">
+                    </div>
+
+                    <div class="form-group">
+                        <label for="music-heading">Music Heading</label>
+                        <input type="text" id="music-heading" name="music_heading" placeholder="e.g., Music">
+                    </div>
+
+                    <div class="form-group">
+                        <label for="books-heading">Books Heading</label>
+                        <input type="text" id="books-heading" name="books_heading" placeholder="e.g., Books">
+                    </div>
+
+                    <div class="form-group">
+                        <label for="pending-requests-heading">Pending Requests Heading</label>
+                        <input type="text" id="pending-requests-heading" name="pending_requests_heading" placeholder="e.g., Pending requests">
+                    </div>
+
+                    <div class="form-group">
+                        <label for="queue-heading">Downloading Now Heading</label>
+                        <input type="text" id="queue-heading" name="queue_heading" placeholder="e.g., Downloading now">
+                    </div>
+
+                    <div class="form-group">
+                        <label for="missing-heading">Missing Heading</label>
+                        <input type="text" id="missing-heading" name="missing_heading" placeholder="e.g., Missing">
+                    </div>
+
+                    <div class="form-group">
+                        <label for="trakt-watchlist-heading">Trakt Watchlist Heading</label>
+                        <input type="text" id="trakt-watchlist-heading" name="trakt_watchlist_heading" placeholder="e.g., From your watchlist: now available">
+                    </div>
+
+                    <div class="form-group">
+                        <label for="recommendations-heading">Recommendations Heading</label>
+                        <input type="text" id="recommendations-heading" name="recommendations_heading" placeholder="e.g., Recommended for you">
+                    </div>
+
+                    <div class="form-group">
+                        <label for="trakt-calendar-heading">Trakt Calendar Heading</label>
+                        <input type="text" id="trakt-calendar-heading" name="trakt_calendar_heading" placeholder="e.g., On your Trakt calendar">
+                    </div>
+
+                    <div class="form-group">
+                        <label for="server-stats-heading">Server Stats Heading</label>
+                        <input type="text" id="server-stats-heading" name="server_stats_heading" placeholder="e.g., Server stats">
+                    </div>
+
                     <div class="form-group">
                         <label for="footer-text">Footer Text</label>
                         <input type="text" id="footer-text" name="footer_text" placeholder="e.g., Generated by Newslettar">
@@ -1444,8 +2233,23 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                 // Update service status
                 updateServiceStatus('sonarr', data.service_status.sonarr);
                 updateServiceStatus('radarr', data.service_status.radarr);
+                updateInstanceStatuses(data.service_status);
                 updateServiceStatus('email', data.service_status.email);
                 updateServiceStatus('trakt', data.service_status.trakt);
+                updateServiceStatus('slack', data.service_status.slack);
+                updateServiceStatus('discord', data.service_status.discord);
+                updateServiceStatus('telegram', data.service_status.telegram);
+                updateServiceStatus('matrix', data.service_status.matrix);
+                updateServiceStatus('ntfy', data.service_status.ntfy);
+                updateServiceStatus('gotify', data.service_status.gotify);
+                updateServiceStatus('webhook', data.service_status.webhook);
+                updateServiceStatus('jellyfin', data.service_status.jellyfin);
+                updateServiceStatus('plex', data.service_status.plex);
+                updateServiceStatus('tautulli', data.service_status.tautulli);
+                updateServiceStatus('bazarr', data.service_status.bazarr);
+                updateServiceStatus('overseerr', data.service_status.overseerr);
+                updateServiceStatus('lidarr', data.service_status.lidarr);
+                updateServiceStatus('readarr', data.service_status.readarr);
 
                 // Update dashboard logs (last 20 lines)
                 const logsResp = await fetch('/api/logs');
@@ -1474,6 +2278,10 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                 indicator.textContent = '⚪';
                 text.textContent = 'Not Configured';
                 text.style.color = '#8899aa';
+            } else if (status === 'misconfigured') {
+                indicator.textContent = '🟠';
+                text.textContent = 'Misconfigured';
+                text.style.color = '#f97316';
             } else if (status === 'error') {
                 indicator.textContent = '🔴';
                 text.textContent = 'Error';
@@ -1481,6 +2289,37 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
             }
         }
 
+        // Per-instance rows (keys look like "sonarr:4K"), only shown when a service has several instances
+        function updateInstanceStatuses(serviceStatus) {
+            const container = document.getElementById('status-instances');
+            container.innerHTML = '';
+
+            ['sonarr', 'radarr', 'readarr'].forEach(service => {
+                const keys = Object.keys(serviceStatus).filter(k => k.startsWith(service + ':')).sort();
+                if (keys.length < 2) return;
+
+                keys.forEach((key, i) => {
+                    const id = service + '-instance-' + i;
+                    const row = document.createElement('div');
+                    row.className = 'stat-row';
+
+                    const label = document.createElement('span');
+                    label.className = 'stat-label';
+                    label.style.paddingLeft = '15px';
+                    label.textContent = '↳ ' + key.substring(service.length + 1) + ':';
+
+                    const value = document.createElement('span');
+                    value.className = 'stat-value';
+                    value.innerHTML = '<span id="status-' + id + '" class="status-indicator">⚫</span> <span id="status-' + id + '-text"></span>';
+
+                    row.appendChild(label);
+                    row.appendChild(value);
+                    container.appendChild(row);
+                    updateServiceStatus(id, serviceStatus[key]);
+                });
+            });
+        }
+
         // Email tag management
         let emailTags = [];
 
@@ -1693,6 +2532,13 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
         // Add event listener for schedule type change
         document.getElementById('schedule_type').addEventListener('change', toggleScheduleType);
 
+        function toggleAdminScheduleType() {
+            const weekly = document.getElementById('admin_schedule_type').value === 'weekly';
+            document.getElementById('admin-day-group').style.display = weekly ? 'block' : 'none';
+        }
+
+        document.getElementById('admin_schedule_type').addEventListener('change', toggleAdminScheduleType);
+
         async function updateTimezoneInfo() {
             const tz = document.getElementById('timezone').value;
             try {
@@ -1715,31 +2561,98 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                 const resp = await fetch('/api/config');
                 const data = await resp.json();
 
+                document.querySelector('[name="sonarr_name"]').value = data.sonarr_name || '';
                 document.querySelector('[name="sonarr_url"]').value = data.sonarr_url || '';
                 document.querySelector('[name="sonarr_api_key"]').value = data.sonarr_api_key || '';
+                document.querySelector('[name="radarr_name"]').value = data.radarr_name || '';
                 document.querySelector('[name="radarr_url"]').value = data.radarr_url || '';
                 document.querySelector('[name="radarr_api_key"]').value = data.radarr_api_key || '';
+                document.querySelector('[name="readarr_name"]').value = data.readarr_name || '';
+                document.querySelector('[name="readarr_url"]').value = data.readarr_url || '';
+                document.querySelector('[name="readarr_api_key"]').value = data.readarr_api_key || '';
+                document.getElementById('sonarr-instances').innerHTML = '';
+                (data.sonarr_instances || []).forEach(inst => addArrInstance('sonarr', inst));
+                document.getElementById('radarr-instances').innerHTML = '';
+                (data.radarr_instances || []).forEach(inst => addArrInstance('radarr', inst));
+                document.getElementById('readarr-instances').innerHTML = '';
+                (data.readarr_instances || []).forEach(inst => addArrInstance('readarr', inst));
                 document.querySelector('[name="trakt_client_id"]').value = data.trakt_client_id || '';
                 originalTraktClientId = data.trakt_client_id || '';
+                document.querySelector('[name="trakt_client_secret"]').value = data.trakt_client_secret || '';
+                document.querySelector('[name="tmdb_api_key"]').value = data.tmdb_api_key || '';
+                document.getElementById('trakt-lists').innerHTML = '';
+                (data.trakt_lists || []).forEach(list => addTraktList(list));
+                updateTraktAccountStatus(data.trakt_connected, data.trakt_username);
+                document.querySelector('[name="jellyfin_server_type"]').value = data.jellyfin_server_type || 'jellyfin';
+                document.querySelector('[name="jellyfin_url"]').value = data.jellyfin_url || '';
+                document.querySelector('[name="jellyfin_api_key"]').value = data.jellyfin_api_key || '';
+                document.querySelector('[name="lidarr_url"]').value = data.lidarr_url || '';
+                document.querySelector('[name="lidarr_api_key"]').value = data.lidarr_api_key || '';
+                document.querySelector('[name="plex_url"]').value = data.plex_url || '';
+                document.querySelector('[name="plex_token"]').value = data.plex_token || '';
+                document.querySelector('[name="tautulli_url"]').value = data.tautulli_url || '';
+                document.querySelector('[name="tautulli_api_key"]').value = data.tautulli_api_key || '';
+                document.querySelector('[name="bazarr_url"]').value = data.bazarr_url || '';
+                document.querySelector('[name="bazarr_api_key"]').value = data.bazarr_api_key || '';
+                document.querySelector('[name="overseerr_url"]').value = data.overseerr_url || '';
+                document.querySelector('[name="overseerr_api_key"]').value = data.overseerr_api_key || '';
                 document.querySelector('[name="smtp_host"]').value = data.smtp_host || 'smtp.mailgun.org';
                 document.querySelector('[name="smtp_port"]').value = data.smtp_port || '587';
                 document.querySelector('[name="smtp_user"]').value = data.smtp_user || '';
                 document.querySelector('[name="smtp_pass"]').value = data.smtp_pass || '';
+                document.querySelector('[name="smtp_security"]').value = data.smtp_security || 'starttls-opportunistic';
+                document.querySelector('[name="smtp_ca_file"]').value = data.smtp_ca_file || '';
+                document.querySelector('[name="smtp_tls_skip_verify"]').value = data.smtp_tls_skip_verify === 'true' ? 'true' : 'false';
                 document.querySelector('[name="from_email"]').value = data.from_email || '';
                 document.querySelector('[name="from_name"]').value = data.from_name || 'Newslettar';
                 document.querySelector('[name="to_emails"]').value = data.to_emails || '';
                 loadEmailTags(data.to_emails || '');
+                document.querySelector('[name="slack_webhook_url"]').value = data.slack_webhook_url || '';
+                document.querySelector('[name="discord_webhook_url"]').value = data.discord_webhook_url || '';
+                document.querySelector('[name="telegram_bot_token"]').value = data.telegram_bot_token || '';
+                document.querySelector('[name="telegram_chat_ids"]').value = data.telegram_chat_ids || '';
+                document.querySelector('[name="telegram_api_url"]').value = data.telegram_api_url || 'https://api.telegram.org';
+                document.querySelector('[name="public_url"]').value = data.public_url || '';
+                document.querySelector('[name="matrix_homeserver_url"]').value = data.matrix_homeserver_url || '';
+                document.querySelector('[name="matrix_access_token"]').value = data.matrix_access_token || '';
+                document.querySelector('[name="matrix_room_id"]').value = data.matrix_room_id || '';
+                document.querySelector('[name="ntfy_url"]').value = data.ntfy_url || 'https://ntfy.sh';
+                document.querySelector('[name="ntfy_topic"]').value = data.ntfy_topic || '';
+                document.querySelector('[name="ntfy_token"]').value = data.ntfy_token || '';
+                document.querySelector('[name="gotify_url"]').value = data.gotify_url || '';
+                document.querySelector('[name="gotify_token"]').value = data.gotify_token || '';
+                document.querySelector('[name="webhook_url"]').value = data.webhook_url || '';
+                document.querySelector('[name="webhook_secret"]').value = data.webhook_secret || '';
                 document.querySelector('[name="timezone"]').value = data.timezone || 'UTC';
                 document.querySelector('[name="schedule_type"]').value = data.schedule_type || 'weekly';
                 document.querySelector('[name="schedule_day"]').value = data.schedule_day || 'Sun';
                 document.querySelector('[name="schedule_day_of_month"]').value = data.schedule_day_of_month || '1';
                 document.querySelector('[name="schedule_time"]').value = data.schedule_time || '09:00';
 
+                document.querySelector('[name="admin_emails"]').value = data.admin_emails || '';
+                document.querySelector('[name="admin_schedule_type"]').value = data.admin_schedule_type || 'daily';
+                document.querySelector('[name="admin_schedule_day"]').value = data.admin_schedule_day || 'Mon';
+                document.querySelector('[name="admin_schedule_time"]').value = data.admin_schedule_time || '08:00';
+
                 // Toggle schedule type visibility
                 toggleScheduleType();
+                toggleAdminScheduleType();
 
                 document.getElementById('show-posters').checked = data.show_posters !== 'false';
                 document.getElementById('show-downloaded').checked = data.show_downloaded !== 'false';
+                document.getElementById('show-music').checked = data.show_music !== 'false';
+                document.getElementById('show-books').checked = data.show_books !== 'false';
+                document.getElementById('show-pending-requests').checked = data.show_pending_requests !== 'false';
+                document.getElementById('show-server-stats').checked = data.show_server_stats !== 'false';
+                document.getElementById('show-subtitles').checked = data.show_subtitles !== 'false';
+                document.getElementById('show-queue').checked = data.show_queue !== 'false';
+                document.getElementById('show-missing').checked = data.show_missing !== 'false';
+                document.getElementById('feed-items').checked = data.feed_items !== 'false';
+                document.getElementById('email-attach-ics').checked = data.email_attach_ics !== 'false';
+                document.getElementById('email-inline-posters').checked = data.email_inline_posters !== 'false';
+                document.getElementById('show-trakt-watchlist').checked = data.show_trakt_watchlist !== 'false';
+                document.getElementById('show-trakt-recommendations').checked = data.show_trakt_recommendations !== 'false';
+                document.getElementById('show-trakt-calendar').checked = data.show_trakt_calendar !== 'false';
                 document.getElementById('show-series-overview').checked = data.show_series_overview !== 'false';
                 document.getElementById('show-episode-overview').checked = data.show_episode_overview !== 'false';
                 document.getElementById('show-unmonitored').checked = data.show_unmonitored !== 'false';
@@ -1765,6 +2678,15 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                 document.getElementById('trakt-watched-movies-limit-container').style.display =
                     data.show_trakt_watched_movies !== 'false' ? 'block' : 'none';
 
+                document.getElementById('show-server-most-watched').checked = data.show_server_most_watched !== 'false';
+                document.getElementById('show-server-recently-added').checked = data.show_server_recently_added !== 'false';
+                document.getElementById('server-most-watched-limit').value = data.server_most_watched_limit || '10';
+                document.getElementById('server-recently-added-limit').value = data.server_recently_added_limit || '10';
+                document.getElementById('server-most-watched-limit-container').style.display =
+                    data.show_server_most_watched !== 'false' ? 'block' : 'none';
+                document.getElementById('server-recently-added-limit-container').style.display =
+                    data.show_server_recently_added !== 'false' ? 'block' : 'none';
+
                 await updateTimezoneInfo();
             } catch (error) {
                 showNotification('Failed to load configuration: ' + error.message, 'error');
@@ -1785,6 +2707,12 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
             // Explicitly ensure to_emails is included even if empty
             data.to_emails = emailTags.join(', ');
 
+            // Additional Sonarr/Radarr/Readarr instances are not named form fields
+            data.sonarr_instances = collectArrInstances('sonarr');
+            data.radarr_instances = collectArrInstances('radarr');
+            data.readarr_instances = collectArrInstances('readarr');
+            data.trakt_lists = collectTraktLists();
+
             const submitBtn = e.target.querySelector('button[type="submit"]');
             submitBtn.classList.add('loading');
             submitBtn.disabled = true;
@@ -1848,16 +2776,64 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
             } else if (type === 'radarr') {
                 endpoint = '/api/test-radarr';
                 payload = { url: data.radarr_url, api_key: data.radarr_api_key };
+            } else if (type === 'readarr') {
+                endpoint = '/api/test-readarr';
+                payload = { url: data.readarr_url, api_key: data.readarr_api_key };
+            } else if (type === 'lidarr') {
+                endpoint = '/api/test-lidarr';
+                payload = { url: data.lidarr_url, api_key: data.lidarr_api_key };
             } else if (type === 'trakt') {
                 endpoint = '/api/test-trakt';
                 payload = { client_id: data.trakt_client_id };
+            } else if (type === 'jellyfin') {
+                endpoint = '/api/test-jellyfin';
+                payload = { url: data.jellyfin_url, api_key: data.jellyfin_api_key, server_type: data.jellyfin_server_type };
+            } else if (type === 'plex') {
+                endpoint = '/api/test-plex';
+                payload = { url: data.plex_url, token: data.plex_token };
+            } else if (type === 'tmdb') {
+                endpoint = '/api/test-tmdb';
+                payload = { api_key: data.tmdb_api_key };
+            } else if (type === 'tautulli') {
+                endpoint = '/api/test-tautulli';
+                payload = { url: data.tautulli_url, api_key: data.tautulli_api_key };
+            } else if (type === 'bazarr') {
+                endpoint = '/api/test-bazarr';
+                payload = { url: data.bazarr_url, api_key: data.bazarr_api_key };
+            } else if (type === 'slack') {
+                endpoint = '/api/test-slack';
+                payload = { webhook_url: data.slack_webhook_url };
+            } else if (type === 'discord') {
+                endpoint = '/api/test-discord';
+                payload = { webhook_url: data.discord_webhook_url };
+            } else if (type === 'telegram') {
+                endpoint = '/api/test-telegram';
+                payload = { token: data.telegram_bot_token, chat_ids: data.telegram_chat_ids, api_url: data.telegram_api_url };
+            } else if (type === 'matrix') {
+                endpoint = '/api/test-matrix';
+                payload = { homeserver_url: data.matrix_homeserver_url, access_token: data.matrix_access_token, room_id: data.matrix_room_id };
+            } else if (type === 'ntfy') {
+                endpoint = '/api/test-ntfy';
+                payload = { url: data.ntfy_url, topic: data.ntfy_topic, token: data.ntfy_token };
+            } else if (type === 'gotify') {
+                endpoint = '/api/test-gotify';
+                payload = { url: data.gotify_url, token: data.gotify_token };
+            } else if (type === 'webhook') {
+                endpoint = '/api/test-webhook';
+                payload = { url: data.webhook_url, secret: data.webhook_secret };
+            } else if (type === 'overseerr') {
+                endpoint = '/api/test-overseerr';
+                payload = { url: data.overseerr_url, api_key: data.overseerr_api_key };
             } else {
                 endpoint = '/api/test-email';
                 payload = {
                     smtp: data.smtp_host,
                     port: data.smtp_port,
                     user: data.smtp_user,
-                    pass: data.smtp_pass
+                    pass: data.smtp_pass,
+                    security: data.smtp_security,
+                    ca_file: data.smtp_ca_file,
+                    skip_verify: data.smtp_tls_skip_verify
                 };
             }
 
@@ -1878,6 +2854,95 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
             }
         }
 
+        // Additional Sonarr/Radarr instance editor
+        function addArrInstance(type, inst) {
+            inst = inst || { name: '', url: '', api_key: '' };
+            const labels = { sonarr: 'Sonarr', radarr: 'Radarr', readarr: 'Readarr' };
+            const placeholderURLs = { sonarr: 'http://localhost:8989', radarr: 'http://localhost:7878', readarr: 'http://localhost:8787' };
+            const label = labels[type];
+            const placeholderURL = placeholderURLs[type];
+
+            const row = document.createElement('div');
+            row.className = 'arr-instance';
+            row.innerHTML =
+                '<div class="form-group"><label>Instance Name</label>' +
+                '<input type="text" class="arr-instance-name" placeholder="e.g., 4K" aria-label="' + label + ' instance name"></div>' +
+                '<div class="form-group"><label>' + label + ' URL</label>' +
+                '<input type="url" class="arr-instance-url" placeholder="' + placeholderURL + '" aria-label="' + label + ' instance URL"></div>' +
+                '<div class="form-group"><label>' + label + ' API Key</label>' +
+                '<input type="text" class="arr-instance-key" placeholder="Your ' + label + ' API key" aria-label="' + label + ' instance API key"></div>' +
+                '<button type="button" class="btn btn-secondary" onclick="testArrInstance(this, \'' + type + '\')"><span>Test</span></button> ' +
+                '<button type="button" class="btn btn-danger" onclick="this.closest(\'.arr-instance\').remove()"><span>Remove</span></button>';
+
+            row.querySelector('.arr-instance-name').value = inst.name || '';
+            row.querySelector('.arr-instance-url').value = inst.url || '';
+            row.querySelector('.arr-instance-key').value = inst.api_key || '';
+            document.getElementById(type + '-instances').appendChild(row);
+        }
+
+        function collectArrInstances(type) {
+            const rows = document.querySelectorAll('#' + type + '-instances .arr-instance');
+            return Array.from(rows).map(row => ({
+                name: row.querySelector('.arr-instance-name').value.trim(),
+                url: row.querySelector('.arr-instance-url').value.trim(),
+                api_key: row.querySelector('.arr-instance-key').value.trim()
+            })).filter(inst => inst.url || inst.api_key);
+        }
+
+        function addTraktList(list) {
+            list = list || { heading: '', url: '', limit: 10 };
+
+            const row = document.createElement('div');
+            row.className = 'arr-instance trakt-list';
+            row.innerHTML =
+                '<div class="form-group"><label>Section Heading</label>' +
+                '<input type="text" class="trakt-list-heading" placeholder="e.g., Staff picks" aria-label="Trakt list section heading"></div>' +
+                '<div class="form-group"><label>List URL</label>' +
+                '<input type="url" class="trakt-list-url" placeholder="https://trakt.tv/users/username/lists/list-name" aria-label="Trakt list URL"></div>' +
+                '<div class="form-group"><label>Number of results (1-50)</label>' +
+                '<input type="number" class="trakt-list-limit" min="1" max="50" placeholder="10" aria-label="Trakt list limit"></div>' +
+                '<button type="button" class="btn btn-danger" onclick="this.closest(\'.trakt-list\').remove()"><span>Remove</span></button>';
+
+            row.querySelector('.trakt-list-heading').value = list.heading || '';
+            row.querySelector('.trakt-list-url').value = list.url || '';
+            row.querySelector('.trakt-list-limit').value = list.limit || 10;
+            document.getElementById('trakt-lists').appendChild(row);
+        }
+
+        function collectTraktLists() {
+            const rows = document.querySelectorAll('#trakt-lists .trakt-list');
+            return Array.from(rows).map(row => ({
+                heading: row.querySelector('.trakt-list-heading').value.trim(),
+                url: row.querySelector('.trakt-list-url').value.trim(),
+                limit: parseInt(row.querySelector('.trakt-list-limit').value, 10) || 10
+            })).filter(list => list.url);
+        }
+
+        async function testArrInstance(button, type) {
+            const row = button.closest('.arr-instance');
+            button.classList.add('loading');
+            button.disabled = true;
+
+            try {
+                const resp = await fetch('/api/test-' + type, {
+                    method: 'POST',
+                    headers: {'Content-Type': 'application/json'},
+                    body: JSON.stringify({
+                        url: row.querySelector('.arr-instance-url').value.trim(),
+                        api_key: row.querySelector('.arr-instance-key').value.trim()
+                    })
+                });
+
+                const result = await resp.json();
+                showNotification(result.message, result.success ? 'success' : 'error');
+            } catch (error) {
+                showNotification('Connection test failed: ' + error.message, 'error');
+            } finally {
+                button.classList.remove('loading');
+                button.disabled = false;
+            }
+        }
+
         async function previewNewsletter() {
             const button = event.target.closest('button');
             button.classList.add('loading');
@@ -1905,6 +2970,57 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
             }
         }
 
+        async function previewAdminDigest() {
+            const button = event.target.closest('button');
+            button.classList.add('loading');
+            button.disabled = true;
+
+            showLoading();
+
+            try {
+                const resp = await fetch('/api/admin-preview', { method: 'POST' });
+                const data = await resp.json();
+
+                if (data.success) {
+                    const iframe = document.getElementById('preview-frame');
+                    iframe.srcdoc = data.html;
+                    document.getElementById('preview-modal').classList.add('show');
+                } else {
+                    showNotification(data.error || 'Failed to generate admin digest', 'error');
+                }
+            } catch (error) {
+                showNotification('Preview failed: ' + error.message, 'error');
+            } finally {
+                button.classList.remove('loading');
+                button.disabled = false;
+                hideLoading();
+            }
+        }
+
+        async function sendAdminDigest() {
+            if (!confirm('Send admin digest now? Save the configuration first if you changed the admin recipients.')) return;
+
+            const button = event.target.closest('button');
+            button.classList.add('loading');
+            button.disabled = true;
+
+            try {
+                const resp = await fetch('/api/admin-send', { method: 'POST' });
+                const data = await resp.json();
+
+                if (data.success) {
+                    showNotification('Admin digest is being sent', 'success');
+                } else {
+                    showNotification(data.message || 'Failed to send admin digest', 'error');
+                }
+            } catch (error) {
+                showNotification('Send failed: ' + error.message, 'error');
+            } finally {
+                button.classList.remove('loading');
+                button.disabled = false;
+            }
+        }
+
         function closePreview() {
             document.getElementById('preview-modal').classList.remove('show');
         }
@@ -1933,6 +3049,17 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                 document.getElementById('watched-series-heading').value = config.watched_series_heading || '';
                 document.getElementById('anticipated-movies-heading').value = config.anticipated_movies_heading || '';
                 document.getElementById('watched-movies-heading').value = config.watched_movies_heading || '';
+                document.getElementById('most-watched-heading').value = config.most_watched_heading || '';
+                document.getElementById('recently-added-heading').value = config.recently_added_heading || '';
+                document.getElementById('music-heading').value = config.music_heading || '';
+                document.getElementById('books-heading').value = config.books_heading || '';
+                document.getElementById('pending-requests-heading').value = config.pending_requests_heading || '';
+                document.getElementById('server-stats-heading').value = config.server_stats_heading || '';
+                document.getElementById('queue-heading').value = config.queue_heading || '';
+                document.getElementById('missing-heading').value = config.missing_heading || '';
+                document.getElementById('trakt-watchlist-heading').value = config.trakt_watchlist_heading || '';
+                document.getElementById('recommendations-heading').value = config.recommendations_heading || '';
+                document.getElementById('trakt-calendar-heading').value = config.trakt_calendar_heading || '';
                 document.getElementById('footer-text').value = config.footer_text || '';
 
                 // Monthly strings
@@ -2018,6 +3145,17 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                     watched_series_heading: document.getElementById('watched-series-heading').value,
                     anticipated_movies_heading: document.getElementById('anticipated-movies-heading').value,
                     watched_movies_heading: document.getElementById('watched-movies-heading').value,
+                    most_watched_heading: document.getElementById('most-watched-heading').value,
+                    recently_added_heading: document.getElementById('recently-added-heading').value,
+                    music_heading: document.getElementById('music-heading').value,
+                    books_heading: document.getElementById('books-heading').value,
+                    pending_requests_heading: document.getElementById('pending-requests-heading').value,
+                    server_stats_heading: document.getElementById('server-stats-heading').value,
+                    queue_heading: document.getElementById('queue-heading').value,
+                    missing_heading: document.getElementById('missing-heading').value,
+                    trakt_watchlist_heading: document.getElementById('trakt-watchlist-heading').value,
+                    recommendations_heading: document.getElementById('recommendations-heading').value,
+                    trakt_calendar_heading: document.getElementById('trakt-calendar-heading').value,
                     footer_text: document.getElementById('footer-text').value,
                     // Monthly strings
                     monthly_email_title: document.getElementById('monthly-email-title').value,
@@ -2083,6 +3221,17 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                 document.getElementById('watched-series-heading').value = 'Most Watched Series (Last Week)';
                 document.getElementById('anticipated-movies-heading').value = 'Most Anticipated Movies (Next Week)';
                 document.getElementById('watched-movies-heading').value = 'Most Watched Movies (Last Week)';
+                document.getElementById('most-watched-heading').value = 'Most watched on our server';
+                document.getElementById('recently-added-heading').value = 'Recently added';
+                document.getElementById('music-heading').value = 'Music';
+                document.getElementById('books-heading').value = 'Books';
+                document.getElementById('pending-requests-heading').value = 'Pending requests';
+                document.getElementById('server-stats-heading').value = 'Server stats';
+                document.getElementById('queue-heading').value = 'Downloading now';
+                document.getElementById('missing-heading').value = 'Missing';
+                document.getElementById('trakt-watchlist-heading').value = 'From your watchlist: now available';
+                document.getElementById('recommendations-heading').value = 'Recommended for you';
+                document.getElementById('trakt-calendar-heading').value = 'On your Trakt calendar';
                 document.getElementById('footer-text').value = 'Generated by Newslettar';
 
                 // Monthly strings
@@ -2142,6 +3291,72 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
             }
         }
 
+        function updateTraktAccountStatus(connected, username) {
+            document.getElementById('trakt-account-status').textContent = connected
+                ? 'Connected as ' + (username || 'Trakt user')
+                : 'No Trakt account connected';
+            document.getElementById('trakt-connect-btn').style.display = connected ? 'none' : '';
+            document.getElementById('trakt-disconnect-btn').style.display = connected ? '' : 'none';
+        }
+
+        let traktPollTimer = null;
+
+        async function connectTrakt() {
+            try {
+                const resp = await fetch('/api/trakt/device-code', { method: 'POST' });
+                const result = await resp.json();
+                if (!result.success) {
+                    showNotification('Trakt login failed: ' + result.message, 'error');
+                    return;
+                }
+
+                document.getElementById('trakt-user-code').textContent = result.user_code;
+                document.getElementById('trakt-device-url').href = result.verification_url;
+                document.getElementById('trakt-device-url').textContent = result.verification_url.replace('https://', '');
+                document.getElementById('trakt-device-code').style.display = 'block';
+                window.open(result.verification_url, '_blank');
+
+                let interval = (result.interval || 5) * 1000;
+                const poll = async () => {
+                    const pollResp = await fetch('/api/trakt/device-poll', { method: 'POST' });
+                    const pollResult = await pollResp.json();
+                    if (pollResult.status === 'pending' || pollResult.status === 'slow_down') {
+                        if (pollResult.status === 'slow_down') interval += 1000;
+                        traktPollTimer = setTimeout(poll, interval);
+                        return;
+                    }
+
+                    document.getElementById('trakt-device-code').style.display = 'none';
+                    if (pollResult.status === 'authorized') {
+                        showNotification('Trakt account connected!', 'success');
+                        loadConfig();
+                    } else if (pollResult.status === 'denied') {
+                        showNotification('Trakt login was denied', 'error');
+                    } else if (pollResult.status === 'expired') {
+                        showNotification('Trakt code expired, please try again', 'error');
+                    } else {
+                        showNotification('Trakt login failed: ' + (pollResult.message || 'unknown error'), 'error');
+                    }
+                };
+                clearTimeout(traktPollTimer);
+                traktPollTimer = setTimeout(poll, interval);
+            } catch (error) {
+                showNotification('Trakt login failed: ' + error.message, 'error');
+            }
+        }
+
+        async function disconnectTrakt() {
+            if (!confirm('Disconnect your Trakt account?')) return;
+            try {
+                const resp = await fetch('/api/trakt/disconnect', { method: 'POST' });
+                const result = await resp.json();
+                showNotification(result.message, result.success ? 'success' : 'error');
+                if (result.success) updateTraktAccountStatus(false, '');
+            } catch (error) {
+                showNotification('Failed to disconnect Trakt: ' + error.message, 'error');
+            }
+        }
+
         function toggleTraktLimit(type) {
             const checkbox = document.getElementById('show-trakt-' + type);
             const container = document.getElementById('trakt-' + type + '-limit-container');
@@ -2149,9 +3364,29 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
             saveTemplateSettings();
         }
 
+        function toggleServerLimit(type) {
+            const checkbox = document.getElementById('show-server-' + type);
+            const container = document.getElementById('server-' + type + '-limit-container');
+            container.style.display = checkbox.checked ? 'block' : 'none';
+            saveTemplateSettings();
+        }
+
         async function saveTemplateSettings() {
             const showPosters = document.getElementById('show-posters').checked;
             const showDownloaded = document.getElementById('show-downloaded').checked;
+            const showMusic = document.getElementById('show-music').checked;
+            const showBooks = document.getElementById('show-books').checked;
+            const showPendingRequests = document.getElementById('show-pending-requests').checked;
+            const showServerStats = document.getElementById('show-server-stats').checked;
+            const showSubtitles = document.getElementById('show-subtitles').checked;
+            const showQueue = document.getElementById('show-queue').checked;
+            const showMissing = document.getElementById('show-missing').checked;
+            const feedItems = document.getElementById('feed-items').checked;
+            const emailAttachICS = document.getElementById('email-attach-ics').checked;
+            const emailInlinePosters = document.getElementById('email-inline-posters').checked;
+            const showTraktWatchlist = document.getElementById('show-trakt-watchlist').checked;
+            const showTraktRecommendations = document.getElementById('show-trakt-recommendations').checked;
+            const showTraktCalendar = document.getElementById('show-trakt-calendar').checked;
             const showSeriesOverview = document.getElementById('show-series-overview').checked;
             const showEpisodeOverview = document.getElementById('show-episode-overview').checked;
             const showUnmonitored = document.getElementById('show-unmonitored').checked;
@@ -2165,6 +3400,10 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
             const traktWatchedSeriesLimit = document.getElementById('trakt-watched-series-limit').value || '5';
             const traktAnticipatedMoviesLimit = document.getElementById('trakt-anticipated-movies-limit').value || '5';
             const traktWatchedMoviesLimit = document.getElementById('trakt-watched-movies-limit').value || '5';
+            const showServerMostWatched = document.getElementById('show-server-most-watched').checked;
+            const showServerRecentlyAdded = document.getElementById('show-server-recently-added').checked;
+            const serverMostWatchedLimit = document.getElementById('server-most-watched-limit').value || '10';
+            const serverRecentlyAddedLimit = document.getElementById('server-recently-added-limit').value || '10';
 
             try {
                 await fetch('/api/config', {
@@ -2173,6 +3412,19 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                     body: JSON.stringify({
                         show_posters: showPosters ? 'true' : 'false',
                         show_downloaded: showDownloaded ? 'true' : 'false',
+                        show_music: showMusic ? 'true' : 'false',
+                        show_books: showBooks ? 'true' : 'false',
+                        show_pending_requests: showPendingRequests ? 'true' : 'false',
+                        show_server_stats: showServerStats ? 'true' : 'false',
+                        show_subtitles: showSubtitles ? 'true' : 'false',
+                        show_queue: showQueue ? 'true' : 'false',
+                        show_missing: showMissing ? 'true' : 'false',
+                        feed_items: feedItems ? 'true' : 'false',
+                        email_attach_ics: emailAttachICS ? 'true' : 'false',
+                        email_inline_posters: emailInlinePosters ? 'true' : 'false',
+                        show_trakt_watchlist: showTraktWatchlist ? 'true' : 'false',
+                        show_trakt_recommendations: showTraktRecommendations ? 'true' : 'false',
+                        show_trakt_calendar: showTraktCalendar ? 'true' : 'false',
                         show_series_overview: showSeriesOverview ? 'true' : 'false',
                         show_episode_overview: showEpisodeOverview ? 'true' : 'false',
                         show_unmonitored: showUnmonitored ? 'true' : 'false',
@@ -2185,7 +3437,11 @@ func getUIHTML(version string, nextRun string, timezone string, installType stri
                         trakt_anticipated_series_limit: traktAnticipatedSeriesLimit,
                         trakt_watched_series_limit: traktWatchedSeriesLimit,
                         trakt_anticipated_movies_limit: traktAnticipatedMoviesLimit,
-                        trakt_watched_movies_limit: traktWatchedMoviesLimit
+                        trakt_watched_movies_limit: traktWatchedMoviesLimit,
+                        show_server_most_watched: showServerMostWatched ? 'true' : 'false',
+                        show_server_recently_added: showServerRecentlyAdded ? 'true' : 'false',
+                        server_most_watched_limit: serverMostWatchedLimit,
+                        server_recently_added_limit: serverRecentlyAddedLimit
                     })
                 });
 
@@ -50,6 +50,44 @@ func getTimezone(tz string) *time.Location {
 	return loc
 }
 
+// seriesKey identifies a series across Sonarr instances (TVDB ID when known, title otherwise)
+func seriesKey(ep Episode) string {
+	if ep.TvdbID > 0 {
+		return fmt.Sprintf("tvdb:%d", ep.TvdbID)
+	}
+	return "title:" + ep.SeriesTitle
+}
+
+// movieKey identifies a movie across Radarr instances (TMDB ID when known, title and year otherwise)
+func movieKey(movie Movie) string {
+	if movie.TmdbID > 0 {
+		return fmt.Sprintf("tmdb:%d", movie.TmdbID)
+	}
+	return fmt.Sprintf("title:%s:%d", movie.Title, movie.Year)
+}
+
+// bookKey identifies a book across Readarr instances (foreign book ID when known, title and author otherwise)
+func bookKey(book Book) string {
+	if book.ForeignBookID != "" {
+		return "id:" + book.ForeignBookID
+	}
+	return fmt.Sprintf("title:%s:%s", book.Title, book.Author)
+}
+
+// mergeInstanceNames returns a new slice with the names of both lists, without duplicates.
+// A new slice is always allocated so cached API results are never modified.
+func mergeInstanceNames(a, b []string) []string {
+	merged := make([]string, 0, len(a)+len(b))
+	seen := make(map[string]bool)
+	for _, name := range append(append([]string{}, a...), b...) {
+		if !seen[name] {
+			seen[name] = true
+			merged = append(merged, name)
+		}
+	}
+	return merged
+}
+
 // Group episodes by series
 func groupEpisodesBySeries(episodes []Episode) []SeriesGroup {
 	seriesMap := make(map[string]*SeriesGroup)
@@ -61,14 +99,15 @@ func groupEpisodesBySeries(episodes []Episode) []SeriesGroup {
 
 	// Track seen episodes per series using map for O(1) lookups instead of O(N)
 	type episodeKey struct {
-		seriesTitle string
-		seasonNum   int
-		episodeNum  int
+		series     string
+		seasonNum  int
+		episodeNum int
 	}
 	seenEpisodes := make(map[episodeKey]bool)
 
 	for _, ep := range episodes {
-		group, exists := seriesMap[ep.SeriesTitle]
+		key := seriesKey(ep)
+		group, exists := seriesMap[key]
 		if !exists {
 			group = &SeriesGroup{
 				SeriesTitle:  ep.SeriesTitle,
@@ -79,18 +118,19 @@ func groupEpisodesBySeries(episodes []Episode) []SeriesGroup {
 				Overview:     ep.SeriesOverview,
 				SeriesRating: ep.Rating, // Get series rating from first episode
 			}
-			seriesMap[ep.SeriesTitle] = group
+			seriesMap[key] = group
 		}
+		group.Instances = mergeInstanceNames(group.Instances, ep.Instances)
 
 		// Check for duplicate episodes using O(1) map lookup instead of O(N) loop
-		key := episodeKey{
-			seriesTitle: ep.SeriesTitle,
-			seasonNum:   ep.SeasonNum,
-			episodeNum:  ep.EpisodeNum,
+		epKey := episodeKey{
+			series:     key,
+			seasonNum:  ep.SeasonNum,
+			episodeNum: ep.EpisodeNum,
 		}
 
-		if !seenEpisodes[key] {
-			seenEpisodes[key] = true
+		if !seenEpisodes[epKey] {
+			seenEpisodes[epKey] = true
 			// Clear episode rating since it's actually the series rating (episodes don't have individual ratings in Sonarr)
 			ep.Rating = 0.0
 			group.Episodes = append(group.Episodes, ep)
@@ -116,53 +156,69 @@ func groupEpisodesBySeries(episodes []Episode) []SeriesGroup {
 	return groups
 }
 
-// Deduplicate episodes by series title, season, and episode number
+// Deduplicate episodes by series (TVDB ID or title), season, and episode number.
+// Episodes reported by several Sonarr instances are kept once with all instance names.
 func deduplicateEpisodes(episodes []Episode) []Episode {
 	type episodeKey struct {
-		seriesTitle string
-		seasonNum   int
-		episodeNum  int
+		series     string
+		seasonNum  int
+		episodeNum int
 	}
 
-	seen := make(map[episodeKey]bool)
+	seen := make(map[episodeKey]int)
 	result := make([]Episode, 0, len(episodes))
 
 	for _, ep := range episodes {
 		key := episodeKey{
-			seriesTitle: ep.SeriesTitle,
-			seasonNum:   ep.SeasonNum,
-			episodeNum:  ep.EpisodeNum,
+			series:     seriesKey(ep),
+			seasonNum:  ep.SeasonNum,
+			episodeNum: ep.EpisodeNum,
 		}
 
-		if !seen[key] {
-			seen[key] = true
-			result = append(result, ep)
+		if idx, exists := seen[key]; exists {
+			result[idx].Instances = mergeInstanceNames(result[idx].Instances, ep.Instances)
+			continue
 		}
+		seen[key] = len(result)
+		result = append(result, ep)
 	}
 
 	return result
 }
 
-// Deduplicate movies by title and year
+// Deduplicate movies by TMDB ID (or title and year).
+// Movies reported by several Radarr instances are kept once with all instance names.
 func deduplicateMovies(movies []Movie) []Movie {
-	type movieKey struct {
-		title string
-		year  int
-	}
-
-	seen := make(map[movieKey]bool)
+	seen := make(map[string]int)
 	result := make([]Movie, 0, len(movies))
 
 	for _, movie := range movies {
-		key := movieKey{
-			title: movie.Title,
-			year:  movie.Year,
+		key := movieKey(movie)
+		if idx, exists := seen[key]; exists {
+			result[idx].Instances = mergeInstanceNames(result[idx].Instances, movie.Instances)
+			continue
 		}
+		seen[key] = len(result)
+		result = append(result, movie)
+	}
 
-		if !seen[key] {
-			seen[key] = true
-			result = append(result, movie)
+	return result
+}
+
+// Deduplicate books by foreign book ID (or title and author).
+// Books reported by several Readarr instances (e.g. ebook and audiobook) are kept once with all instance names.
+func deduplicateBooks(books []Book) []Book {
+	seen := make(map[string]int)
+	result := make([]Book, 0, len(books))
+
+	for _, book := range books {
+		key := bookKey(book)
+		if idx, exists := seen[key]; exists {
+			result[idx].Instances = mergeInstanceNames(result[idx].Instances, book.Instances)
+			continue
 		}
+		seen[key] = len(result)
+		result = append(result, book)
 	}
 
 	return result
@@ -205,6 +261,11 @@ func convertToCronExpression(day, timeStr, scheduleType string, dayOfMonth int)
 		minute = parts[1]
 	}
 
+	// Handle daily schedules (used by the admin digest)
+	if scheduleType == "daily" {
+		return fmt.Sprintf("%s %s * * *", minute, hour)
+	}
+
 	// Handle monthly schedules
 	if scheduleType == "monthly" {
 		// Validate day of month (1-31)
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeInstanceNames(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []string
+		want []string
+	}{
+		{"both empty", nil, nil, []string{}},
+		{"disjoint", []string{"Sonarr"}, []string{"Sonarr 4K"}, []string{"Sonarr", "Sonarr 4K"}},
+		{"overlapping", []string{"Sonarr", "Anime"}, []string{"Anime", "Sonarr 4K"}, []string{"Sonarr", "Anime", "Sonarr 4K"}},
+		{"duplicates within one list", []string{"Sonarr", "Sonarr"}, nil, []string{"Sonarr"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergeInstanceNames(tt.a, tt.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeInstanceNames(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeInstanceNamesDoesNotModifyInput(t *testing.T) {
+	a := make([]string, 1, 4)
+	a[0] = "Sonarr"
+	mergeInstanceNames(a, []string{"Sonarr 4K"})
+	if got := a[:2]; got[1] != "" {
+		t.Errorf("mergeInstanceNames wrote into the backing array of its input: %q", got)
+	}
+}
+
+func TestDeduplicateEpisodes(t *testing.T) {
+	tests := []struct {
+		name     string
+		episodes []Episode
+		want     []Episode
+	}{
+		{
+			name: "same TVDB ID on two instances",
+			episodes: []Episode{
+				{SeriesTitle: "Show", TvdbID: 1, SeasonNum: 1, EpisodeNum: 2, Instances: []string{"Sonarr"}},
+				{SeriesTitle: "Show (2024)", TvdbID: 1, SeasonNum: 1, EpisodeNum: 2, Instances: []string{"Sonarr 4K"}},
+			},
+			want: []Episode{
+				{SeriesTitle: "Show", TvdbID: 1, SeasonNum: 1, EpisodeNum: 2, Instances: []string{"Sonarr", "Sonarr 4K"}},
+			},
+		},
+		{
+			name: "different episodes of a series",
+			episodes: []Episode{
+				{SeriesTitle: "Show", TvdbID: 1, SeasonNum: 1, EpisodeNum: 1},
+				{SeriesTitle: "Show", TvdbID: 1, SeasonNum: 1, EpisodeNum: 2},
+			},
+			want: []Episode{
+				{SeriesTitle: "Show", TvdbID: 1, SeasonNum: 1, EpisodeNum: 1},
+				{SeriesTitle: "Show", TvdbID: 1, SeasonNum: 1, EpisodeNum: 2},
+			},
+		},
+		{
+			name: "title is the key without a TVDB ID",
+			episodes: []Episode{
+				{SeriesTitle: "Show", SeasonNum: 2, EpisodeNum: 5, Instances: []string{"Sonarr"}},
+				{SeriesTitle: "Show", SeasonNum: 2, EpisodeNum: 5, Instances: []string{"Anime"}},
+				{SeriesTitle: "Other Show", SeasonNum: 2, EpisodeNum: 5, Instances: []string{"Anime"}},
+			},
+			want: []Episode{
+				{SeriesTitle: "Show", SeasonNum: 2, EpisodeNum: 5, Instances: []string{"Sonarr", "Anime"}},
+				{SeriesTitle: "Other Show", SeasonNum: 2, EpisodeNum: 5, Instances: []string{"Anime"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := deduplicateEpisodes(tt.episodes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("deduplicateEpisodes() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeduplicateMovies(t *testing.T) {
+	tests := []struct {
+		name   string
+		movies []Movie
+		want   []Movie
+	}{
+		{
+			name: "same TMDB ID on two instances",
+			movies: []Movie{
+				{Title: "Movie", Year: 2024, TmdbID: 603, Instances: []string{"Radarr"}},
+				{Title: "Movie", Year: 2024, TmdbID: 603, Instances: []string{"Radarr 4K"}},
+			},
+			want: []Movie{
+				{Title: "Movie", Year: 2024, TmdbID: 603, Instances: []string{"Radarr", "Radarr 4K"}},
+			},
+		},
+		{
+			name: "title and year are the key without a TMDB ID",
+			movies: []Movie{
+				{Title: "Remake", Year: 1990, Instances: []string{"Radarr"}},
+				{Title: "Remake", Year: 2024, Instances: []string{"Radarr"}},
+				{Title: "Remake", Year: 2024, Instances: []string{"Radarr 4K"}},
+			},
+			want: []Movie{
+				{Title: "Remake", Year: 1990, Instances: []string{"Radarr"}},
+				{Title: "Remake", Year: 2024, Instances: []string{"Radarr", "Radarr 4K"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := deduplicateMovies(tt.movies); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("deduplicateMovies() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
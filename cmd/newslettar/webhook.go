@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// The generic webhook POSTs the structured newsletter data as JSON for automations
+// (Home Assistant, n8n, ...). When a secret is configured, the body is signed with
+// HMAC-SHA256 and the hex digest is sent as "X-Newslettar-Signature: sha256=<digest>".
+
+const (
+	webhookSignatureHeader = "X-Newslettar-Signature"
+	webhookEventHeader     = "X-Newslettar-Event"
+	webhookEventSent       = "newsletter.sent"
+	webhookEventTest       = "test"
+)
+
+type webhookMedia struct {
+	Series []SeriesGroup `json:"series"`
+	Movies []Movie       `json:"movies"`
+}
+
+type webhookTrakt struct {
+	AnticipatedSeries []TraktShow        `json:"anticipated_series"`
+	WatchedSeries     []TraktShow        `json:"watched_series"`
+	AnticipatedMovies []TraktMovie       `json:"anticipated_movies"`
+	WatchedMovies     []TraktMovie       `json:"watched_movies"`
+	WatchlistSeries   []TraktShow        `json:"watchlist_series"`
+	WatchlistMovies   []TraktMovie       `json:"watchlist_movies"`
+	RecommendedSeries []TraktShow        `json:"recommended_series"`
+	RecommendedMovies []TraktMovie       `json:"recommended_movies"`
+	CalendarSeries    []TraktShow        `json:"calendar_series"`
+	CalendarMovies    []TraktMovie       `json:"calendar_movies"`
+	Lists             []TraktListSection `json:"lists"`
+}
+
+// webhookPayload is the JSON document sent for every newsletter
+type webhookPayload struct {
+	Event        string       `json:"event"`
+	Subject      string       `json:"subject"`
+	Title        string       `json:"title"`
+	ScheduleType string       `json:"schedule_type"` // "weekly" or "monthly"
+	PeriodStart  time.Time    `json:"period_start"`  // Start of the downloaded window
+	PeriodEnd    time.Time    `json:"period_end"`    // End of the downloaded window and start of the upcoming window
+	UpcomingEnd  time.Time    `json:"upcoming_end"`
+	ArchiveURL   string       `json:"archive_url,omitempty"`
+	Summary      string       `json:"summary"`
+	Upcoming     webhookMedia `json:"upcoming"`
+	Downloaded   webhookMedia `json:"downloaded"`
+	Trakt        webhookTrakt `json:"trakt"`
+}
+
+// orEmpty returns an empty slice instead of nil, so receivers always get [] rather than null
+func orEmpty[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+	return items
+}
+
+// buildWebhookPayload converts the issue into the webhook JSON document
+func buildWebhookPayload(issue *NewsletterIssue) webhookPayload {
+	data := issue.Data
+	scheduleType := "weekly"
+	if issue.Period.Monthly {
+		scheduleType = "monthly"
+	}
+	payload := webhookPayload{
+		Event:        webhookEventSent,
+		Subject:      issue.Subject,
+		Title:        data.EmailTitle,
+		ScheduleType: scheduleType,
+		PeriodStart:  issue.Period.Start,
+		PeriodEnd:    issue.Period.End,
+		UpcomingEnd:  issue.Period.UpcomingEnd,
+		ArchiveURL:   issue.ArchiveURL,
+		Summary:      newsletterSummary(data, issue.Period),
+		Upcoming: webhookMedia{
+			Series: orEmpty(data.UpcomingSeriesGroups),
+			Movies: orEmpty(data.UpcomingMovies),
+		},
+		Downloaded: webhookMedia{
+			Series: []SeriesGroup{},
+			Movies: []Movie{},
+		},
+		Trakt: webhookTrakt{
+			AnticipatedSeries: orEmpty(data.TraktAnticipatedSeries),
+			WatchedSeries:     orEmpty(data.TraktWatchedSeries),
+			AnticipatedMovies: orEmpty(data.TraktAnticipatedMovies),
+			WatchedMovies:     orEmpty(data.TraktWatchedMovies),
+			WatchlistSeries:   orEmpty(data.TraktWatchlistShows),
+			WatchlistMovies:   orEmpty(data.TraktWatchlistMovies),
+			RecommendedSeries: orEmpty(data.TraktRecommendedShows),
+			RecommendedMovies: orEmpty(data.TraktRecommendedMovies),
+			CalendarSeries:    orEmpty(data.TraktCalendarShows),
+			CalendarMovies:    orEmpty(data.TraktCalendarMovies),
+			Lists:             make([]TraktListSection, 0, len(data.TraktListSections)),
+		},
+	}
+	if data.ShowDownloaded {
+		payload.Downloaded = webhookMedia{
+			Series: orEmpty(data.DownloadedSeriesGroups),
+			Movies: orEmpty(data.DownloadedMovies),
+		}
+	}
+	// Copy the list sections: the issue data is shared with the other notifiers
+	for _, list := range data.TraktListSections {
+		list.Shows = orEmpty(list.Shows)
+		list.Movies = orEmpty(list.Movies)
+		payload.Trakt.Lists = append(payload.Trakt.Lists, list)
+	}
+	return payload
+}
+
+// signWebhookBody returns the signature header value for a request body
+func signWebhookBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// postWebhook sends a JSON payload to the webhook, signing the exact bytes that are sent
+func postWebhook(ctx context.Context, webhookURL, secret, event string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	headers := map[string]string{webhookEventHeader: event}
+	if secret != "" {
+		headers[webhookSignatureHeader] = signWebhookBody(secret, body)
+	}
+	return pushJSON(ctx, "POST", webhookURL, headers, body)
+}
+
+// webhookNotifier posts the full structured newsletter to a generic webhook
+type webhookNotifier struct {
+	cfg *Config
+}
+
+func (n webhookNotifier) Name() string { return "webhook" }
+
+func (n webhookNotifier) Send(ctx context.Context, issue *NewsletterIssue) error {
+	return postWebhook(ctx, n.cfg.WebhookURL, n.cfg.WebhookSecret, webhookEventSent, buildWebhookPayload(issue))
+}
@@ -0,0 +1,39 @@
+package main
+
+import "testing"
+
+func TestSignWebhookBody(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret string
+		body   string
+		want   string
+	}{
+		{
+			// RFC 4231 test case 2
+			name:   "rfc 4231",
+			secret: "Jefe",
+			body:   "what do ya want for nothing?",
+			want:   "sha256=5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843",
+		},
+		{
+			name:   "empty body",
+			secret: "key",
+			body:   "",
+			want:   "sha256=5d5d139563c95b5967b9bd9a8c9b233a9dedb45072794cd232dc1b74832607d0",
+		},
+		{
+			name:   "json payload",
+			secret: "s3cret",
+			body:   `{"event":"newsletter.sent"}`,
+			want:   "sha256=dfa81561483eb3e397bd97f033ae15b432df01e651f858dbc13a36f69f25e8b3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := signWebhookBody(tt.secret, []byte(tt.body)); got != tt.want {
+				t.Errorf("signWebhookBody(%q, %q) = %q, want %q", tt.secret, tt.body, got, tt.want)
+			}
+		})
+	}
+}